@@ -0,0 +1,489 @@
+package is
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// ABARoutingParts holds the components of an ABA routing transit number.
+type ABARoutingParts struct {
+	// Prefix is the first two digits: 00 for the United States government,
+	// 01 to 12 for the Federal Reserve districts, 21 to 32 for thrift
+	// institutions and 61 to 72 for electronic transactions in the same
+	// districts, and 80 for traveler's cheques.
+	Prefix string
+	// District is the Federal Reserve district, from 1 to 12, or 0 for the
+	// prefixes 00 and 80.
+	District int
+	// RoutingSymbol is the Federal Reserve routing symbol, the first 4 digits.
+	RoutingSymbol string
+	// Institution is the ABA institution identifier, the next 4 digits.
+	Institution string
+	// CheckDigit is the last digit.
+	CheckDigit string
+}
+
+// ABARouting check if the string is an ABA routing transit number of 9
+// digits, such as 021000021, with a valid prefix and check digit. The digits
+// weighted 3, 7 and 1 in turn add up to a multiple of 10.
+func ABARouting(str string) bool {
+	_, err := ParseABARouting(str)
+	return err == nil
+}
+
+// ParseABARouting validates an ABA routing transit number like ABARouting and
+// returns its parts. On failure the error is a *ValidationError.
+func ParseABARouting(str string) (ABARoutingParts, error) {
+	switch {
+	case str == "":
+		return ABARoutingParts{}, invalid("ABARouting", CodeEmpty, -1)
+	case len(str) < 9:
+		return ABARoutingParts{}, invalid("ABARouting", CodeTooShort, -1)
+	case len(str) > 9:
+		return ABARoutingParts{}, invalid("ABARouting", CodeTooLong, 9)
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return ABARoutingParts{}, invalid("ABARouting", CodeInvalidChar, i)
+		}
+		sum += digitAt(str, i) * [3]int{3, 7, 1}[i%3]
+	}
+
+	prefix := digitAt(str, 0)*10 + digitAt(str, 1)
+	district := 0
+	switch {
+	case prefix == 0, prefix == 80:
+	case 1 <= prefix && prefix <= 12:
+		district = prefix
+	case 21 <= prefix && prefix <= 32:
+		district = prefix - 20
+	case 61 <= prefix && prefix <= 72:
+		district = prefix - 60
+	default:
+		return ABARoutingParts{}, invalid("ABARouting", CodeBadFormat, 0)
+	}
+	if sum%10 != 0 {
+		return ABARoutingParts{}, invalid("ABARouting", CodeBadChecksum, 8)
+	}
+	return ABARoutingParts{
+		Prefix:        str[:2],
+		District:      district,
+		RoutingSymbol: str[:4],
+		Institution:   str[4:8],
+		CheckDigit:    str[8:],
+	}, nil
+}
+
+// BSBParts holds the components of an Australian bank state branch number.
+type BSBParts struct {
+	// Bank is the first two digits, which identify the financial institution.
+	Bank string
+	// State is the third digit, which identifies the state of the branch.
+	State string
+	// Branch is the last three digits.
+	Branch string
+}
+
+// BSB check if the string is an Australian bank state branch number of 6
+// digits, written as 062-000, 062 000 or 062000. BSB numbers have no check
+// digit.
+func BSB(str string) bool {
+	_, err := ParseBSB(str)
+	return err == nil
+}
+
+// ParseBSB validates a BSB number like BSB and returns its parts. On failure
+// the error is a *ValidationError.
+func ParseBSB(str string) (BSBParts, error) {
+	digits, err := groupedDigits("BSB", str, 3, 3)
+	if err != nil {
+		return BSBParts{}, err
+	}
+	return BSBParts{Bank: digits[:2], State: digits[2:3], Branch: digits[3:]}, nil
+}
+
+// CATransitParts holds the components of a Canadian routing number.
+type CATransitParts struct {
+	// Institution is the 3 digit financial institution number.
+	Institution string
+	// Transit is the 5 digit branch transit number.
+	Transit string
+}
+
+// CATransit check if the string is a Canadian routing number, either in the
+// electronic form of 9 digits, a leading 0, the institution number and the
+// transit number, as in 000412345, or in the cheque (MICR) form of the
+// transit number, a hyphen and the institution number, as in 12345-004.
+func CATransit(str string) bool {
+	_, err := ParseCATransit(str)
+	return err == nil
+}
+
+// ParseCATransit validates a Canadian routing number like CATransit and
+// returns its parts. On failure the error is a *ValidationError.
+func ParseCATransit(str string) (CATransitParts, error) {
+	if len(str) == 9 && str[5] == '-' {
+		for i := 0; i < len(str); i++ {
+			if i != 5 && (str[i] < '0' || str[i] > '9') {
+				return CATransitParts{}, invalid("CATransit", CodeInvalidChar, i)
+			}
+		}
+		return CATransitParts{Institution: str[6:], Transit: str[:5]}, nil
+	}
+	switch {
+	case str == "":
+		return CATransitParts{}, invalid("CATransit", CodeEmpty, -1)
+	case len(str) < 9:
+		return CATransitParts{}, invalid("CATransit", CodeTooShort, -1)
+	case len(str) > 9:
+		return CATransitParts{}, invalid("CATransit", CodeTooLong, 9)
+	}
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return CATransitParts{}, invalid("CATransit", CodeInvalidChar, i)
+		}
+	}
+	if str[0] != '0' {
+		return CATransitParts{}, invalid("CATransit", CodeBadFormat, 0)
+	}
+	return CATransitParts{Institution: str[1:4], Transit: str[4:]}, nil
+}
+
+// SortCode check if the string is a UK sort code of 6 digits, written as
+// 20-00-00, 20 00 00 or 200000. Use SortCodeModulusTable.Check to also check
+// an account number.
+func SortCode(str string) bool {
+	_, err := sortCodeDigits(str)
+	return err == nil
+}
+
+// sortCodeDigits returns the 6 digits of a sort code.
+func sortCodeDigits(str string) (string, error) {
+	return groupedDigits("SortCode", str, 2, 2, 2)
+}
+
+// SortCodeParts holds a checked UK sort code and account number.
+type SortCodeParts struct {
+	// SortCode is the 6 digit sort code without separators.
+	SortCode string
+	// Account is the 8 digit account number, padded with zeros.
+	Account string
+}
+
+// Modulus checking methods of SortCodeModulusEntry.
+const (
+	// SortCodeMod10 requires the weighted sum to be a multiple of 10.
+	SortCodeMod10 = "MOD10"
+	// SortCodeMod11 requires the weighted sum to be a multiple of 11.
+	SortCodeMod11 = "MOD11"
+	// SortCodeDoubleAlternate adds up the digits of the products instead,
+	// and requires the sum to be a multiple of 10.
+	SortCodeDoubleAlternate = "DBLAL"
+)
+
+// SortCodeModulusEntry stores a row of the UK modulus checking table
+type SortCodeModulusEntry struct {
+	// Start and End are the inclusive bounds of the sort code range.
+	Start, End string
+	// Method is SortCodeMod10, SortCodeMod11 or SortCodeDoubleAlternate.
+	Method string
+	// Weights apply to the 6 digits of the sort code followed by the 8
+	// digits of the account number.
+	Weights [14]int
+	// Exception is the number of the exception rule of the row, or 0.
+	Exception int
+}
+
+// SortCodeModulusTable is the modulus checking table that Vocalink publishes
+// for UK sort codes and account numbers, see ParseSortCodeModulusTable.
+type SortCodeModulusTable []SortCodeModulusEntry
+
+// ParseSortCodeModulusTable reads a modulus checking table in the format of
+// the valacdos.txt file published by Vocalink: one row per line with the
+// first and last sort code of a range, the method, 14 weights and an
+// optional exception number, separated by spaces.
+func ParseSortCodeModulusTable(r io.Reader) (SortCodeModulusTable, error) {
+	var table SortCodeModulusTable
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 17 && len(fields) != 18 {
+			return nil, fmt.Errorf("is: modulus table line %d: expected 17 or 18 fields, got %d", line, len(fields))
+		}
+		e := SortCodeModulusEntry{Start: fields[0], End: fields[1], Method: fields[2]}
+		if len(e.Start) != 6 || !Numeric(e.Start) || len(e.End) != 6 || !Numeric(e.End) || e.Start > e.End {
+			return nil, fmt.Errorf("is: modulus table line %d: invalid sort code range %s-%s", line, e.Start, e.End)
+		}
+		switch e.Method {
+		case SortCodeMod10, SortCodeMod11, SortCodeDoubleAlternate:
+		default:
+			return nil, fmt.Errorf("is: modulus table line %d: unknown method %q", line, e.Method)
+		}
+		for i := range e.Weights {
+			w, err := strconv.Atoi(fields[3+i])
+			if err != nil {
+				return nil, fmt.Errorf("is: modulus table line %d: invalid weight %q", line, fields[3+i])
+			}
+			e.Weights[i] = w
+		}
+		if len(fields) == 18 {
+			x, err := strconv.Atoi(fields[17])
+			if err != nil || x <= 0 {
+				return nil, fmt.Errorf("is: modulus table line %d: invalid exception %q", line, fields[17])
+			}
+			e.Exception = x
+		}
+		table = append(table, e)
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return table, nil
+}
+
+// SortCodeSubstitutions maps sort codes to the sort codes that replace them
+// in the checks of exception 5, see ParseSortCodeSubstitutions.
+type SortCodeSubstitutions map[string]string
+
+// ParseSortCodeSubstitutions reads a sort code substitution table in the
+// format of the scsubtab.txt file published by Vocalink: one line per sort
+// code with the original and the substitute sort code, separated by spaces.
+func ParseSortCodeSubstitutions(r io.Reader) (SortCodeSubstitutions, error) {
+	subs := make(SortCodeSubstitutions)
+	sc := bufio.NewScanner(r)
+	for line := 1; sc.Scan(); line++ {
+		fields := strings.Fields(sc.Text())
+		if len(fields) == 0 {
+			continue
+		}
+		if len(fields) != 2 || len(fields[0]) != 6 || !Numeric(fields[0]) || len(fields[1]) != 6 || !Numeric(fields[1]) {
+			return nil, fmt.Errorf("is: substitution table line %d: expected two sort codes", line)
+		}
+		subs[fields[0]] = fields[1]
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	return subs, nil
+}
+
+// Check validates a UK sort code, as accepted by SortCode, and an account
+// number of 8 digits, or 6 or 7 digits that are padded with zeros on the
+// left, and returns them. It is CheckWith without a substitution table.
+func (t SortCodeModulusTable) Check(sortCode, account string) (SortCodeParts, error) {
+	return t.CheckWith(sortCode, account, nil)
+}
+
+// CheckWith is like Check, with the substitution table that exception 5
+// needs; without it, the sort codes of exception 5 are not substituted. The
+// account is checked by the rows of the table whose range holds the sort
+// code, applying the exception rules 1 to 14 of the Vocalink specification
+// "Validating account numbers". Sort codes outside the table cannot be
+// checked and are accepted, as are the foreign currency accounts of
+// exception 6. An exception number above 14, which the specification does
+// not define, is reported with the parts and a *ValidationError of code
+// CodeNotCheckable. On other failures the error is a *ValidationError whose
+// offset refers to the argument at fault.
+func (t SortCodeModulusTable) CheckWith(sortCode, account string, subs SortCodeSubstitutions) (SortCodeParts, error) {
+	code, err := sortCodeDigits(sortCode)
+	if err != nil {
+		return SortCodeParts{}, err
+	}
+	switch {
+	case account == "":
+		return SortCodeParts{}, invalid("SortCode", CodeEmpty, -1)
+	case len(account) < 6:
+		return SortCodeParts{}, invalid("SortCode", CodeTooShort, -1)
+	case len(account) > 8:
+		return SortCodeParts{}, invalid("SortCode", CodeTooLong, 8)
+	}
+	for i := 0; i < len(account); i++ {
+		if account[i] < '0' || account[i] > '9' {
+			return SortCodeParts{}, invalid("SortCode", CodeInvalidChar, i)
+		}
+	}
+	parts := SortCodeParts{code, strings.Repeat("0", 8-len(account)) + account}
+
+	var rows []SortCodeModulusEntry
+	for _, e := range t {
+		if e.Start <= code && code <= e.End {
+			if e.Exception > 14 {
+				return parts, invalid("SortCode", CodeNotCheckable, -1)
+			}
+			rows = append(rows, e)
+		}
+	}
+	if len(rows) == 0 {
+		return parts, nil
+	}
+
+	// the digits u v w x y z of the sort code and a b c d e f g h of the account
+	var d sortCodeDigitList
+	for i := range d {
+		d[i] = digitAt(parts.SortCode+parts.Account, i)
+	}
+	if rows[0].Exception == 5 {
+		if s, ok := subs[code]; ok {
+			for i := 0; i < 6; i++ {
+				d[i] = digitAt(s, i)
+			}
+		}
+	}
+	if rows[0].Exception == 6 && 4 <= d[scA] && d[scA] <= 8 && d[scG] == d[scH] {
+		// a foreign currency account, which the checks do not apply to
+		return parts, nil
+	}
+
+	var ok bool
+	switch {
+	case len(rows) == 2 && (rows[0].Exception == 2 && rows[1].Exception == 9 ||
+		rows[0].Exception == 10 && rows[1].Exception == 11 ||
+		rows[0].Exception == 12 && rows[1].Exception == 13):
+		// either check may pass
+		ok = rows[0].check(d) || rows[1].check(d)
+	default:
+		ok = true
+		for _, e := range rows {
+			if e.Exception == 3 && (d[scC] == 6 || d[scC] == 9) {
+				continue
+			}
+			if !e.check(d) {
+				ok = false
+				break
+			}
+		}
+	}
+	if !ok {
+		return SortCodeParts{}, invalid("SortCode", CodeBadChecksum, -1)
+	}
+	return parts, nil
+}
+
+// sortCodeDigitList holds the 6 digits of a sort code followed by the 8
+// digits of an account number.
+type sortCodeDigitList [14]int
+
+// Positions in a sortCodeDigitList, named as in the Vocalink specification.
+const (
+	scA = 6 + iota
+	scB
+	scC
+	scD
+	scE
+	scF
+	scG
+	scH
+)
+
+// check runs the modulus check of the row, with its exception rule.
+func (e SortCodeModulusEntry) check(d sortCodeDigitList) bool {
+	w := e.Weights
+	switch e.Exception {
+	case 2:
+		switch {
+		case d[scA] != 0 && d[scG] != 9:
+			w = [14]int{0, 0, 1, 2, 5, 3, 6, 4, 8, 7, 10, 9, 3, 1}
+		case d[scA] != 0:
+			w = [14]int{0, 0, 0, 0, 0, 0, 0, 0, 8, 7, 10, 9, 3, 1}
+		}
+	case 7:
+		if d[scG] == 9 {
+			for i := 0; i < scC; i++ {
+				w[i] = 0
+			}
+		}
+	case 8:
+		d[0], d[1], d[2], d[3], d[4], d[5] = 0, 9, 0, 1, 2, 6
+	case 9:
+		d[0], d[1], d[2], d[3], d[4], d[5] = 3, 0, 9, 6, 3, 4
+	case 10:
+		if (d[scA] == 0 || d[scA] == 9) && d[scB] == 9 && d[scG] == 9 {
+			for i := 0; i < scC; i++ {
+				w[i] = 0
+			}
+		}
+	}
+
+	sum := 0
+	for i, x := range w {
+		p := d[i] * x
+		if e.Method == SortCodeDoubleAlternate {
+			p = p/10 + p%10
+		}
+		sum += p
+	}
+	if e.Exception == 1 {
+		sum += 27
+	}
+
+	var ok bool
+	switch {
+	case e.Method == SortCodeMod11 && e.Exception == 4:
+		ok = sum%11 == d[scG]*10+d[scH]
+	case e.Method == SortCodeMod11 && e.Exception == 5:
+		// g is the check digit
+		r := sum % 11
+		ok = r != 1 && (11-r)%11 == d[scG]
+	case e.Method == SortCodeMod11:
+		ok = sum%11 == 0
+	case e.Exception == 5:
+		// h is the check digit
+		ok = (10-sum%10)%10 == d[scH]
+	default:
+		ok = sum%10 == 0
+	}
+
+	if !ok && e.Exception == 14 && (d[scH] == 0 || d[scH] == 1 || d[scH] == 9) {
+		// drop h and shift the account right, as for some Coutts accounts
+		copy(d[scB:], d[scA:scH])
+		d[scA] = 0
+		e.Exception = 0
+		return e.check(d)
+	}
+	return ok
+}
+
+// groupedDigits returns the digits of str, which is made of groups of the
+// given sizes separated by single hyphens or single spaces, or of the digits
+// alone. Errors are reported for the validator.
+func groupedDigits(validator, str string, groups ...int) (string, error) {
+	n := 0
+	for _, g := range groups {
+		n += g
+	}
+	grouped := len(groups) > 1 && len(str) == n+len(groups)-1
+	switch {
+	case str == "":
+		return "", invalid(validator, CodeEmpty, -1)
+	case len(str) < n:
+		return "", invalid(validator, CodeTooShort, -1)
+	case len(str) > n && !grouped:
+		return "", invalid(validator, CodeTooLong, n)
+	}
+
+	var b strings.Builder
+	i := 0
+	for k, g := range groups {
+		if grouped && k > 0 {
+			// every separator is the same as the first one
+			if c := str[i]; c != str[groups[0]] || c != '-' && c != ' ' {
+				return "", invalid(validator, CodeInvalidChar, i)
+			}
+			i++
+		}
+		for end := i + g; i < end; i++ {
+			if str[i] < '0' || str[i] > '9' {
+				return "", invalid(validator, CodeInvalidChar, i)
+			}
+			b.WriteByte(str[i])
+		}
+	}
+	return b.String(), nil
+}
@@ -0,0 +1,314 @@
+package is
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestABARouting(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"011000015", true},
+		{"021000021", true},
+		{"026009593", true},
+		{"322271627", true},
+		{"061000104", true},
+		{"021000022", false},
+		{"131000021", false},
+		{"02100002", false},
+		{"0210000210", false},
+		{"02100002a", false},
+	}
+	for _, test := range tests {
+		if actual := ABARouting(test.param); actual != test.expected {
+			t.Errorf("Expected ABARouting(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseABARouting(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected ABARoutingParts
+	}{
+		{"021000021", ABARoutingParts{"02", 2, "0210", "0002", "1"}},
+		{"322271627", ABARoutingParts{"32", 12, "3222", "7162", "7"}},
+		{"000000000", ABARoutingParts{"00", 0, "0000", "0000", "0"}},
+	}
+	for _, test := range tests {
+		actual, err := ParseABARouting(test.param)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParseABARouting(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestParseABARoutingErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"02100002", CodeTooShort, -1},
+		{"0210000210", CodeTooLong, 9},
+		{"0210-0002", CodeInvalidChar, 4},
+		{"131000021", CodeBadFormat, 0},
+		{"021000022", CodeBadChecksum, 8},
+	}
+	for _, test := range tests {
+		_, err := ParseABARouting(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "ABARouting" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseABARouting(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestParseBSB(t *testing.T) {
+	t.Parallel()
+
+	for _, param := range []string{"062-000", "062 000", "062000"} {
+		actual, err := ParseBSB(param)
+		if expected := (BSBParts{"06", "2", "000"}); err != nil || actual != expected {
+			t.Errorf("Expected ParseBSB(%q) to be %+v, got %+v, %v", param, expected, actual, err)
+		}
+	}
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"06200", CodeTooShort, -1},
+		{"0620001", CodeInvalidChar, 3},
+		{"06200012", CodeTooLong, 6},
+		{"062_000", CodeInvalidChar, 3},
+		{"062-00a", CodeInvalidChar, 6},
+		{"06a000", CodeInvalidChar, 2},
+	}
+	for _, test := range tests {
+		_, err := ParseBSB(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "BSB" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseBSB(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestParseCATransit(t *testing.T) {
+	t.Parallel()
+
+	for _, param := range []string{"000412345", "12345-004"} {
+		actual, err := ParseCATransit(param)
+		if expected := (CATransitParts{"004", "12345"}); err != nil || actual != expected {
+			t.Errorf("Expected ParseCATransit(%q) to be %+v, got %+v, %v", param, expected, actual, err)
+		}
+	}
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"00041234", CodeTooShort, -1},
+		{"0004123456", CodeTooLong, 9},
+		{"100412345", CodeBadFormat, 0},
+		{"12345-0a4", CodeInvalidChar, 7},
+		{"12345 004", CodeInvalidChar, 5},
+	}
+	for _, test := range tests {
+		_, err := ParseCATransit(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "CATransit" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseCATransit(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestSortCode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"20-00-00", true},
+		{"20 00 00", true},
+		{"200000", true},
+		{"20-00 00", false},
+		{"20.00.00", false},
+		{"2000000", false},
+		{"20000", false},
+		{"20-0a-00", false},
+	}
+	for _, test := range tests {
+		if actual := SortCode(test.param); actual != test.expected {
+			t.Errorf("Expected SortCode(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+// sortCodeTable holds test rows in the format of valacdos.txt. 107999 has
+// two rows, both of which must pass. The rows with exceptions 1 and 5 are
+// those of the test cases of the Vocalink specification.
+const sortCodeTable = `
+089999 089999 MOD10    0    0    0    0    0    0    7    1    3    7    1    3    7    1
+107999 107999 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1
+107999 107999 DBLAL    2    1    2    1    2    1    2    1    2    1    2    1    2    1
+202959 202959 DBLAL    2    1    2    1    2    1    2    1    2    1    2    1    2    1
+500000 500099 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1  15
+118765 118765 DBLAL    0    0    2    1    2    1    2    1    2    1    2    1    2    1   1
+309070 309072 MOD11    0    0    1    2    5    3    6    4    8    7   10    9    3    1   2
+309070 309072 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1   9
+827101 827101 MOD10    0    0    0    0    0    0    7    1    3    7    1    3    7    1
+827101 827101 DBLAL    2    1    2    1    2    1    2    1    2    1    2    1    2    1   3
+134020 134020 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    0    0   4
+938000 938696 MOD11    7    6    5    4    3    2    7    6    5    4    3    2    0    0   5
+938000 938696 DBLAL    2    1    2    1    2    1    2    1    2    1    2    1    2    0   5
+200915 200915 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1   6
+772798 772798 MOD11    0    0    1    2    5    3    6    4    8    7   10    9    3    1   7
+086090 086090 MOD11    1    1    1    1    1    1    8    7    6    5    4    3    2    1   8
+871427 871427 MOD11    0    0    1    2    5    3    6    4    8    7   10    9    3    1  10
+871427 871427 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1  11
+074456 074456 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1  12
+074456 074456 MOD10    0    0    0    0    0    0    7    1    3    7    1    3    7    1  13
+180002 180002 MOD11    0    0    0    0    0    0    8    7    6    5    4    3    2    1  14
+`
+
+// sortCodeSubstitutions holds test rows in the format of scsubtab.txt.
+const sortCodeSubstitutions = `
+938600 938611
+938602 938343
+`
+
+func TestSortCodeModulusTable(t *testing.T) {
+	t.Parallel()
+
+	table, err := ParseSortCodeModulusTable(strings.NewReader(sortCodeTable))
+	if err != nil || len(table) != 21 || table[4].Exception != 15 {
+		t.Fatalf("Expected ParseSortCodeModulusTable to read 21 rows, got %+v, %v", table, err)
+	}
+	subs, err := ParseSortCodeSubstitutions(strings.NewReader(sortCodeSubstitutions))
+	if err != nil || len(subs) != 2 || subs["938600"] != "938611" {
+		t.Fatalf("Expected ParseSortCodeSubstitutions to read 2 rows, got %v, %v", subs, err)
+	}
+
+	var tests = []struct {
+		sortCode string
+		account  string
+		expected bool
+	}{
+		{"08-99-99", "66374958", true},
+		{"089999", "66374959", false},
+		{"107999", "88837491", false},
+		{"107999", "88837492", false},
+		{"107999", "88837998", true},
+		{"20-29-59", "63748472", true},
+		{"20-29-59", "63748473", false},
+		// no row to check against
+		{"40-00-00", "12345678", true},
+		{"20-29-59", "6374847", false},
+		{"20-29-59", "1234a678", false},
+		{"20-29-5", "63748472", false},
+		// exception 1 adds 27
+		{"118765", "64371389", true},
+		{"118765", "64371388", false},
+		// exceptions 2 and 9: other weights if a is not 0, or sort code 309634
+		{"309070", "12345677", true},
+		{"309070", "99345694", true},
+		{"309070", "12345679", true},
+		{"309070", "12345678", false},
+		{"309070", "02355688", false},
+		// exception 3 skips the second check if c is 6 or 9
+		{"827101", "12645673", true},
+		{"827101", "12545676", false},
+		// exception 4 compares the remainder to gh
+		{"134020", "12345610", true},
+		{"134020", "12345603", false},
+		// exception 5 checks g and h
+		{"938611", "07806039", true},
+		{"938063", "55065200", true},
+		{"938063", "15764273", false},
+		{"938063", "15764264", false},
+		{"938063", "15763217", false},
+		// exception 6 skips foreign currency accounts
+		{"200915", "41011166", true},
+		{"200915", "31011166", false},
+		// exception 7 drops the weights of u to b if g is 9
+		{"772798", "99345694", true},
+		{"772798", "99345695", false},
+		// exception 8 checks against sort code 090126
+		{"086090", "12345672", true},
+		{"086090", "12345673", false},
+		// exceptions 10 and 11, and 12 and 13: either check may pass
+		{"871427", "46238510", true},
+		{"871427", "09123496", true},
+		{"871427", "99123496", true},
+		{"871427", "12345679", true},
+		{"871427", "12345678", false},
+		{"074456", "12345672", true},
+		{"074456", "12345679", true},
+		{"074456", "12345678", false},
+		// exception 14 retries without h if h is 0, 1 or 9
+		{"180002", "00000191", true},
+		{"180002", "00000190", true},
+		{"180002", "00000199", true},
+		{"180002", "00000192", false},
+		{"180002", "00001909", false},
+	}
+	for _, test := range tests {
+		if _, err := table.CheckWith(test.sortCode, test.account, subs); (err == nil) != test.expected {
+			t.Errorf("Expected CheckWith(%q, %q) to be %v, got %v", test.sortCode, test.account, test.expected, err)
+		}
+	}
+
+	// exception 5 substitutes the sort code
+	if _, err := table.CheckWith("938600", "42368003", subs); err != nil {
+		t.Errorf("Expected CheckWith(%q, %q) with substitutions to succeed, got %v", "938600", "42368003", err)
+	}
+	if _, err := table.Check("938600", "42368003"); err == nil {
+		t.Errorf("Expected Check(%q, %q) without substitutions to fail", "938600", "42368003")
+	}
+
+	parts, err := table.Check("40-00-00", "123456")
+	if expected := (SortCodeParts{"400000", "00123456"}); err != nil || parts != expected {
+		t.Errorf("Expected Check(%q, %q) to be %+v, got %+v, %v", "40-00-00", "123456", expected, parts, err)
+	}
+
+	// exceptions above 14 are not defined
+	parts, err = table.Check("50-00-01", "12345678")
+	ve, ok := err.(*ValidationError)
+	if expected := (SortCodeParts{"500001", "12345678"}); !ok || ve.Code != CodeNotCheckable || parts != expected {
+		t.Errorf("Expected Check(%q, %q) to be %+v with %s, got %+v, %v", "50-00-01", "12345678", expected, CodeNotCheckable, parts, err)
+	}
+
+	for _, bad := range []string{
+		"089999 089999 MOD10 0 0 0",
+		"089999 089998 MOD10 0 0 0 0 0 0 7 1 3 7 1 3 7 1",
+		"089999 089999 MOD12 0 0 0 0 0 0 7 1 3 7 1 3 7 1",
+		"089999 089999 MOD10 0 0 0 0 0 0 7 1 3 7 1 3 7 x",
+		"089999 089999 MOD10 0 0 0 0 0 0 7 1 3 7 1 3 7 1 0",
+	} {
+		if _, err := ParseSortCodeModulusTable(strings.NewReader(bad)); err == nil {
+			t.Errorf("Expected ParseSortCodeModulusTable(%q) to fail", bad)
+		}
+	}
+	for _, bad := range []string{"938600", "938600 93861", "938600 938611 1", "93860x 938611"} {
+		if _, err := ParseSortCodeSubstitutions(strings.NewReader(bad)); err == nil {
+			t.Errorf("Expected ParseSortCodeSubstitutions(%q) to fail", bad)
+		}
+	}
+}
@@ -3,6 +3,7 @@
 package is
 
 import (
+	"fmt"
 	"path/filepath"
 	"reflect"
 	"runtime"
@@ -107,7 +108,35 @@ var (
 		{RGBcolor, "Space", " "},
 		{RGBcolor, "False", "rgba(0,31,255)"},
 		{RGBcolor, "True", "rgb(0,31,255)"},
+		// CreditCard
+		{CreditCard, "Empty", ""},
+		{CreditCard, "Space", " "},
+		{CreditCard, "False", "5398228707871528"},
+		{CreditCard, "True", "4716-2210-5188-5662"},
+		// ISBN10
+		{ISBN10, "Empty", ""},
+		{ISBN10, "Space", " "},
+		{ISBN10, "False", "3-423-21412-1"},
+		{ISBN10, "True", "3 401 01319 X"},
+		// ISBN13
+		{ISBN13, "Empty", ""},
+		{ISBN13, "Space", " "},
+		{ISBN13, "False", "978 3 8362 2119 0"},
+		{ISBN13, "True", "978-4-87311-368-5"},
+		// UUID
+		{UUID, "Empty", ""},
+		{UUID, "Space", " "},
+		{UUID, "False", "aaaaaaaa-1111-1111-aaag-111111111111"},
+		{UUID, "True", "a987fbc9-4bed-3078-cf07-9141ba07c9f3"},
+		// UUIDv7
+		{UUIDv7, "Empty", ""},
+		{UUIDv7, "Space", " "},
+		{UUIDv7, "False", "017f22e2-79b0-7cc3-c8c4-dc0c0c07398f"},
+		{UUIDv7, "True", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f"},
 	}
+
+	// zeroAllocFuncs must not allocate, whatever their input
+	zeroAllocFuncs = []func(string) bool{CreditCard, ISBN10, ISBN13, UUID, UUIDv7}
 )
 
 func getFuncName(f interface{}) string {
@@ -127,3 +156,43 @@ func Benchmark(b *testing.B) {
 		})
 	}
 }
+
+func TestZeroAllocs(t *testing.T) {
+	for _, bencCase := range benchmarkFuncs {
+		if !isZeroAllocFunc(bencCase.function) {
+			continue
+		}
+		name := getFuncName(bencCase.function) + "." + bencCase.caseName
+		allocs := testing.AllocsPerRun(100, func() {
+			bencCase.function(bencCase.param)
+		})
+		if allocs != 0 {
+			t.Errorf("Expected %s to make 0 allocs/op, got %v", name, allocs)
+		}
+	}
+}
+
+func isZeroAllocFunc(f func(string) bool) bool {
+	for _, z := range zeroAllocFuncs {
+		if reflect.ValueOf(z).Pointer() == reflect.ValueOf(f).Pointer() {
+			return true
+		}
+	}
+	return false
+}
+
+func BenchmarkNetworkSet(b *testing.B) {
+	networks := make([]string, 0, 4096)
+	for i := 0; i < 4096; i++ {
+		networks = append(networks, fmt.Sprintf("10.%d.%d.0/24", i/256, i%256))
+	}
+	set, err := NewNetworkSet(networks...)
+	if err != nil {
+		b.Fatal(err)
+	}
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Contains("10.15.255.1")
+	}
+}
@@ -0,0 +1,69 @@
+package is
+
+// BICParts is a Business Identifier Code (ISO 9362, also called SWIFT code)
+// split into its components.
+type BICParts struct {
+	// Institution is the 4-letter business party prefix, e.g. DEUT.
+	Institution string
+	// CountryCode is the ISO 3166 alpha-2 code of the institution's country.
+	CountryCode string
+	// Location is the 2-character location code.
+	Location string
+	// Branch is the 3-character branch code, or empty for an 8-character
+	// BIC, which denotes the primary office like the branch code XXX.
+	Branch string
+	// Test reports whether this is a test and training BIC, whose location
+	// code ends in 0.
+	Test bool
+}
+
+// BIC check if the string is a Business Identifier Code (SWIFT code) of 8 or
+// 11 characters, e.g. DEUTDEFF or DEUTDEFF500. Lower case letters are accepted.
+func BIC(str string) bool {
+	_, err := ParseBIC(str)
+	return err == nil
+}
+
+// ParseBIC validates a BIC like BIC and returns its components in upper case.
+// The country must be listed in ISO3166List, except for XK (Kosovo). On
+// failure the error is a *ValidationError.
+func ParseBIC(str string) (BICParts, error) {
+	var parts BICParts
+	switch n := len(str); {
+	case n == 0:
+		return parts, invalid("BIC", CodeEmpty, -1)
+	case n < 8:
+		return parts, invalid("BIC", CodeTooShort, -1)
+	case n > 11:
+		return parts, invalid("BIC", CodeTooLong, 11)
+	case n != 8 && n != 11:
+		return parts, invalid("BIC", CodeBadLength, -1)
+	}
+
+	var buf [11]byte
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		isLetter := 'A' <= c && c <= 'Z'
+		// the institution and country are letters, the rest alphanumeric
+		if !isLetter && (i < 6 || c < '0' || c > '9') {
+			return parts, invalid("BIC", CodeInvalidChar, i)
+		}
+		buf[i] = c
+	}
+	bic := string(buf[:len(str)])
+
+	parts = BICParts{
+		Institution: bic[:4],
+		CountryCode: bic[4:6],
+		Location:    bic[6:8],
+		Branch:      bic[8:],
+		Test:        bic[7] == '0',
+	}
+	if !bankCountry(parts.CountryCode) {
+		return BICParts{}, invalid("BIC", CodeUnknownCountry, 4)
+	}
+	return parts, nil
+}
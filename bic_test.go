@@ -0,0 +1,85 @@
+package is
+
+import "testing"
+
+func TestBIC(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"DEUTDEFF", true},
+		{"DEUTDEFF500", true},
+		{"deutdeff500", true},
+		{"NEDSZAJJXXX", true},
+		{"BOFAUS3N", true},
+		{"CHASUS33", true},
+		{"UNCRITMM", true},
+		{"NBRBXKPR", true},
+		{"ABCDGB20", true},
+		{"DEUTDEF", false},
+		{"DEUTDEFF5", false},
+		{"DEUTDEFF50", false},
+		{"DEUTDEFF5000", false},
+		{"DEU1DEFF", false},
+		{"DEUTD3FF", false},
+		{"DEUTDE-F", false},
+		{"DEUTDEFF50_", false},
+		{"DEUT DEFF", false},
+		{"DEUTXXFF", false},
+	}
+	for _, test := range tests {
+		if actual := BIC(test.param); actual != test.expected {
+			t.Errorf("Expected BIC(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseBIC(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected BICParts
+	}{
+		{"DEUTDEFF", BICParts{"DEUT", "DE", "FF", "", false}},
+		{"deutdeff500", BICParts{"DEUT", "DE", "FF", "500", false}},
+		{"NEDSZAJJXXX", BICParts{"NEDS", "ZA", "JJ", "XXX", false}},
+		{"ABCDGB20", BICParts{"ABCD", "GB", "20", "", true}},
+		{"ABCDGBA0XXX", BICParts{"ABCD", "GB", "A0", "XXX", true}},
+	}
+	for _, test := range tests {
+		actual, err := ParseBIC(test.param)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParseBIC(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestParseBICErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"DEUTDEF", CodeTooShort, -1},
+		{"DEUTDEFF5", CodeBadLength, -1},
+		{"DEUTDEFF5000", CodeTooLong, 11},
+		{"DEU1DEFF", CodeInvalidChar, 3},
+		{"DEUTD3FF", CodeInvalidChar, 5},
+		{"DEUTDEFF50_", CodeInvalidChar, 10},
+		{"DEUTXXFF", CodeUnknownCountry, 4},
+	}
+	for _, test := range tests {
+		_, err := ParseBIC(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "BIC" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseBIC(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
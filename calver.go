@@ -0,0 +1,139 @@
+package is
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// calverTokens are the conventions of calver.org, longest first so that
+// YYYY is not read as YY twice. The width is the exact number of digits, or
+// 0 for numbers without leading zeros, or -2 for at least 2 digits.
+var calverTokens = []struct {
+	name  string
+	width int
+}{
+	{"MAJOR", 0},
+	{"MINOR", 0},
+	{"MICRO", 0},
+	{"YYYY", 4},
+	{"YY", 0},
+	{"0Y", -2},
+	{"MM", 0},
+	{"0M", 2},
+	{"WW", 0},
+	{"0W", 2},
+	{"DD", 0},
+	{"0D", 2},
+}
+
+// CalVer check if the string is a calendar version following the format, a
+// scheme in the conventions of calver.org such as YYYY.0M.0D or
+// YY.0M.MICRO. The format is made of these tokens and of literal
+// separators:
+//
+//	YYYY    full year, 2006
+//	YY      short year, 6, 16 or 106 for 2006, 2016 and 2106
+//	0Y      zero-padded short year, 06, 16 or 106
+//	MM, 0M  month, 1 or 01
+//	WW, 0W  week of the year, 1 or 01
+//	DD, 0D  day of the month, 1 or 01
+//	MAJOR, MINOR, MICRO
+//	        numbers, without leading zeros
+//
+// Months, weeks and days must be in range, and days must exist in the month,
+// of the year if the format has one.
+func CalVer(str, format string) bool {
+	return CheckCalVer(str, format) == nil
+}
+
+// CheckCalVer is like CalVer but returns a *ValidationError explaining why str was rejected.
+func CheckCalVer(str, format string) error {
+	if code, offset := calver(str, format); code != "" {
+		return invalid("CalVer", code, offset)
+	}
+	return nil
+}
+
+func calver(str, format string) (string, int) {
+	if str == "" {
+		return CodeEmpty, -1
+	}
+	year, month, day, dayOffset := -1, 0, 0, -1
+	i := 0
+	for j := 0; j < len(format); {
+		k := 0
+		for k < len(calverTokens) && !strings.HasPrefix(format[j:], calverTokens[k].name) {
+			k++
+		}
+		if i == len(str) {
+			return CodeTooShort, -1
+		}
+		if k == len(calverTokens) {
+			// a literal separator
+			if str[i] != format[j] {
+				return CodeInvalidChar, i
+			}
+			i++
+			j++
+			continue
+		}
+
+		tok := calverTokens[k]
+		j += len(tok.name)
+		start := i
+		for i < len(str) && '0' <= str[i] && str[i] <= '9' && (tok.width <= 0 || i-start < tok.width) {
+			i++
+		}
+		digits := str[start:i]
+		switch {
+		case digits == "" || len(digits) < tok.width || len(digits) < -tok.width:
+			if i == len(str) {
+				return CodeTooShort, -1
+			}
+			return CodeInvalidChar, i
+		case tok.width == 0 && len(digits) > 1 && digits[0] == '0',
+			tok.width < 0 && len(digits) > -tok.width && digits[0] == '0':
+			return CodeInvalidChar, start
+		}
+		v, err := strconv.Atoi(digits)
+		if err != nil {
+			return CodeTooLong, start
+		}
+
+		switch tok.name {
+		case "YYYY":
+			year = v
+		case "YY", "0Y":
+			year = 2000 + v
+		case "MM", "0M":
+			if v < 1 || v > 12 {
+				return CodeBadFormat, start
+			}
+			month = v
+		case "WW", "0W":
+			if v < 1 || v > 53 {
+				return CodeBadFormat, start
+			}
+		case "DD", "0D":
+			if v < 1 || v > 31 {
+				return CodeBadFormat, start
+			}
+			day, dayOffset = v, start
+		}
+	}
+	if i < len(str) {
+		return CodeTooLong, i
+	}
+
+	if day > 0 && month > 0 {
+		if year < 0 {
+			// any leap year
+			year = 2000
+		}
+		if day > time.Date(year, time.Month(month)+1, 0, 0, 0, 0, 0, time.UTC).Day() {
+			return CodeBadFormat, dayOffset
+		}
+	}
+	return "", 0
+}
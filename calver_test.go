@@ -0,0 +1,79 @@
+package is
+
+import "testing"
+
+func TestCalVer(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		format   string
+		expected bool
+	}{
+		{"2024.05.17", "YYYY.0M.0D", true},
+		{"2024.5.17", "YYYY.MM.DD", true},
+		{"2024.02.29", "YYYY.0M.0D", true},
+		{"2023.02.29", "YYYY.0M.0D", false},
+		{"2024.04.31", "YYYY.0M.0D", false},
+		{"2024.13.01", "YYYY.0M.0D", false},
+		{"2024.00.01", "YYYY.0M.0D", false},
+		{"2024.5.17", "YYYY.0M.0D", false},
+		{"2024.05.17", "YYYY.MM.DD", false},
+		{"24.05.17", "YYYY.0M.0D", false},
+		{"20240517", "YYYY0M0D", true},
+		{"2024051", "YYYY0M0D", false},
+		{"24.01.3", "YY.0M.MICRO", true},
+		{"24.01.0", "YY.0M.MICRO", true},
+		{"24.01.03", "YY.0M.MICRO", false},
+		{"6.1.0", "YY.MM.MICRO", true},
+		{"106.1.0", "YY.MM.MICRO", true},
+		{"06.1.0", "YY.MM.MICRO", false},
+		{"06.1.0", "0Y.MM.MICRO", true},
+		{"106.1.0", "0Y.MM.MICRO", true},
+		{"6.1.0", "0Y.MM.MICRO", false},
+		{"2024.53", "YYYY.WW", true},
+		{"2024.54", "YYYY.WW", false},
+		{"2024.07", "YYYY.0W", true},
+		{"2.29", "MM.DD", true},
+		{"2.30", "MM.DD", false},
+		{"2024.1.2-beta", "YYYY.MINOR.MICRO-beta", true},
+		{"2024.1.2-rc", "YYYY.MINOR.MICRO-beta", false},
+		{"1.2024.04", "MAJOR.YYYY.0M", true},
+		{"2024.05.17.1", "YYYY.0M.0D", false},
+		{"", "YYYY.0M.0D", false},
+	}
+	for _, test := range tests {
+		if actual := CalVer(test.param, test.format); actual != test.expected {
+			t.Errorf("Expected CalVer(%q, %q) to be %v, got %v", test.param, test.format, test.expected, actual)
+		}
+	}
+}
+
+func TestCheckCalVer(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		format string
+		code   string
+		offset int
+	}{
+		{"", "YYYY.0M.0D", CodeEmpty, -1},
+		{"2024.05", "YYYY.0M.0D", CodeTooShort, -1},
+		{"2024.05.1", "YYYY.0M.0D", CodeTooShort, -1},
+		{"2024.05.17.1", "YYYY.0M.0D", CodeTooLong, 10},
+		{"2024-05-17", "YYYY.0M.0D", CodeInvalidChar, 4},
+		{"2024.5.17", "YYYY.0M.0D", CodeInvalidChar, 6},
+		{"2024.05.17", "YYYY.MM.DD", CodeInvalidChar, 5},
+		{"2024.13.01", "YYYY.0M.0D", CodeBadFormat, 5},
+		{"2023.02.29", "YYYY.0M.0D", CodeBadFormat, 8},
+		{"24.0.99999999999999999999", "YY.MINOR.MICRO", CodeTooLong, 5},
+	}
+	for _, test := range tests {
+		err := CheckCalVer(test.param, test.format)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "CalVer" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected CheckCalVer(%q, %q) to fail with %s at %d, got %v", test.param, test.format, test.code, test.offset, err)
+		}
+	}
+}
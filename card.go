@@ -0,0 +1,198 @@
+package is
+
+import (
+	"strconv"
+	"time"
+)
+
+// Brand is a payment card network.
+type Brand string
+
+// Card brands recognized by CardBrand.
+const (
+	Visa       Brand = "visa"
+	Mastercard Brand = "mastercard"
+	Amex       Brand = "amex"
+	Discover   Brand = "discover"
+	JCB        Brand = "jcb"
+	Diners     Brand = "diners"
+	UnionPay   Brand = "unionpay"
+	Maestro    Brand = "maestro"
+	Mir        Brand = "mir"
+	RuPay      Brand = "rupay"
+	Elo        Brand = "elo"
+	Troy       Brand = "troy"
+)
+
+// CardIINEntry stores an issuer identification number range of a card brand
+type CardIINEntry struct {
+	Brand Brand
+	// Low and High are the inclusive bounds of the PAN prefix; they have the same length.
+	Low, High string
+	// Lengths lists the valid PAN lengths.
+	Lengths []int
+}
+
+var (
+	lengths16     = []int{16}
+	lengths16to19 = []int{16, 17, 18, 19}
+	lengths14to19 = []int{14, 15, 16, 17, 18, 19}
+	lengths12to19 = []int{12, 13, 14, 15, 16, 17, 18, 19}
+)
+
+// CardIINList maps IIN ranges to card brands. When ranges overlap, the one
+// with the longest prefix wins, so co-branded ranges such as Elo's inside
+// Visa's are listed alongside the broader range.
+var CardIINList = []CardIINEntry{
+	{Visa, "4", "4", []int{13, 16, 19}},
+	{Mastercard, "51", "55", lengths16},
+	{Mastercard, "2221", "2720", lengths16},
+	{Amex, "34", "34", []int{15}},
+	{Amex, "37", "37", []int{15}},
+	{Discover, "6011", "6011", lengths16to19},
+	{Discover, "644", "649", lengths16to19},
+	{Discover, "65", "65", lengths16to19},
+	{Discover, "622126", "622925", lengths16to19},
+	{JCB, "3528", "3589", lengths16to19},
+	{Diners, "300", "305", lengths14to19},
+	{Diners, "3095", "3095", lengths14to19},
+	{Diners, "36", "36", lengths14to19},
+	{Diners, "38", "39", lengths14to19},
+	{UnionPay, "62", "62", lengths16to19},
+	{UnionPay, "8100", "8171", lengths16to19},
+	{Maestro, "5018", "5018", lengths12to19},
+	{Maestro, "5020", "5020", lengths12to19},
+	{Maestro, "5038", "5038", lengths12to19},
+	{Maestro, "5893", "5893", lengths12to19},
+	{Maestro, "6304", "6304", lengths12to19},
+	{Maestro, "6759", "6759", lengths12to19},
+	{Maestro, "6761", "6763", lengths12to19},
+	{Mir, "2200", "2204", lengths16to19},
+	{RuPay, "508500", "508999", lengths16},
+	{RuPay, "606985", "607984", lengths16},
+	{RuPay, "608001", "608500", lengths16},
+	{RuPay, "652150", "653149", lengths16},
+	{Elo, "401178", "401179", lengths16},
+	{Elo, "431274", "431274", lengths16},
+	{Elo, "438935", "438935", lengths16},
+	{Elo, "451416", "451416", lengths16},
+	{Elo, "457393", "457393", lengths16},
+	{Elo, "457631", "457632", lengths16},
+	{Elo, "504175", "504175", lengths16},
+	{Elo, "506699", "506778", lengths16},
+	{Elo, "509000", "509999", lengths16},
+	{Elo, "627780", "627780", lengths16},
+	{Elo, "636297", "636297", lengths16},
+	{Elo, "636368", "636368", lengths16},
+	{Elo, "650031", "650033", lengths16},
+	{Elo, "650035", "650051", lengths16},
+	{Elo, "650405", "650439", lengths16},
+	{Elo, "650485", "650538", lengths16},
+	{Elo, "650541", "650598", lengths16},
+	{Elo, "650700", "650718", lengths16},
+	{Elo, "650720", "650727", lengths16},
+	{Elo, "650901", "650978", lengths16},
+	{Elo, "651652", "651679", lengths16},
+	{Elo, "655000", "655019", lengths16},
+	{Elo, "655021", "655058", lengths16},
+	{Troy, "9792", "9792", lengths16},
+}
+
+// cardCVVLength is the number of digits of the card security code of each brand.
+var cardCVVLength = map[Brand]int{
+	Visa: 3, Mastercard: 3, Amex: 4, Discover: 3, JCB: 3, Diners: 3,
+	UnionPay: 3, Maestro: 3, Mir: 3, RuPay: 3, Elo: 3, Troy: 3,
+}
+
+// CardBrand returns the brand of a complete card number, which may contain
+// spaces and hyphens between the digits. It returns false if no brand's IIN
+// range matches or the number has the wrong length for its brand. The check
+// digit is not verified; use CreditCard for that.
+func CardBrand(pan string) (Brand, bool) {
+	digits := make([]byte, 0, 19)
+	for i := 0; i < len(pan); i++ {
+		switch c := pan[i]; {
+		case '0' <= c && c <= '9':
+			digits = append(digits, c)
+		case c == ' ' || c == '-':
+		default:
+			return "", false
+		}
+	}
+	e := cardIIN(digits)
+	if e == nil || !containsInt(e.Lengths, len(digits)) {
+		return "", false
+	}
+	return e.Brand, true
+}
+
+// cardIINRange is a CardIINEntry with its bounds parsed.
+type cardIINRange struct {
+	n         int
+	low, high int
+	entry     *CardIINEntry
+}
+
+// cardIINRanges holds the parsed ranges of CardIINList, in the same order.
+var cardIINRanges = parseCardIINList()
+
+func parseCardIINList() []cardIINRange {
+	ranges := make([]cardIINRange, len(CardIINList))
+	for i := range CardIINList {
+		e := &CardIINList[i]
+		low, err1 := strconv.Atoi(e.Low)
+		high, err2 := strconv.Atoi(e.High)
+		if err1 != nil || err2 != nil || len(e.Low) != len(e.High) || len(e.Low) > 6 {
+			panic("is: bad CardIINList range " + e.Low + "-" + e.High)
+		}
+		ranges[i] = cardIINRange{len(e.Low), low, high, e}
+	}
+	return ranges
+}
+
+// cardIIN returns the most specific CardIINList entry matching the ASCII digits, or nil.
+func cardIIN(digits []byte) *CardIINEntry {
+	// prefix[n] is the number formed by the first n digits
+	var prefix [7]int
+	for i := 1; i < len(prefix) && i <= len(digits); i++ {
+		prefix[i] = prefix[i-1]*10 + int(digits[i-1]-'0')
+	}
+	var best *cardIINRange
+	for i := range cardIINRanges {
+		r := &cardIINRanges[i]
+		if len(digits) < r.n || (best != nil && best.n >= r.n) {
+			continue
+		}
+		if p := prefix[r.n]; r.low <= p && p <= r.high {
+			best = r
+		}
+	}
+	if best == nil {
+		return nil
+	}
+	return best.entry
+}
+
+// CardCVV check if the string is a card security code (CVV, CVC, CID) of the given brand.
+func CardCVV(brand Brand, cvv string) bool {
+	n, ok := cardCVVLength[brand]
+	return ok && len(cvv) == n && Numeric(cvv)
+}
+
+// CardExpiry check if a card expiring at the end of month mm of year yy is
+// still valid at now. The month is 1 or 2 digits and the year 2 or 4 digits.
+func CardExpiry(mm, yy string, now time.Time) bool {
+	if len(mm) < 1 || len(mm) > 2 || !Numeric(mm) || (len(yy) != 2 && len(yy) != 4) || !Numeric(yy) {
+		return false
+	}
+	month, _ := strconv.Atoi(mm)
+	year, _ := strconv.Atoi(yy)
+	if month < 1 || month > 12 {
+		return false
+	}
+	if len(yy) == 2 {
+		year += 2000
+	}
+	y, m, _ := now.Date()
+	return year > y || (year == y && month >= int(m))
+}
@@ -0,0 +1,136 @@
+package is
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCardBrand(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param string
+		brand Brand
+		ok    bool
+	}{
+		{"", "", false},
+		{"foo", "", false},
+		{"4111111111111111", Visa, true},
+		{"4111 1111 1111 1111", Visa, true},
+		{"4111-1111-1111-1111", Visa, true},
+		{"4111.1111.1111.1111", "", false},
+		{"4222222222222", Visa, true},
+		{"4000000000000000006", Visa, true},
+		{"41111111111111111", "", false},
+		{"5555555555554444", Mastercard, true},
+		{"2221000000000009", Mastercard, true},
+		{"2720999999999996", Mastercard, true},
+		{"378282246310005", Amex, true},
+		{"371449635398431", Amex, true},
+		{"3782822463100051", "", false},
+		{"6011111111111117", Discover, true},
+		{"6221260000000000", Discover, true},
+		{"3530111333300000", JCB, true},
+		{"3566002020360505", JCB, true},
+		{"30569309025904", Diners, true},
+		{"36227206271667", Diners, true},
+		{"6200000000000005", UnionPay, true},
+		{"6205500000000000004", UnionPay, true},
+		{"8100000000000002", UnionPay, true},
+		{"6759649826438453", Maestro, true},
+		{"501800000009", Maestro, true},
+		{"2200000000000004", Mir, true},
+		{"6521500000000006", RuPay, true},
+		{"6362970000457013", Elo, true},
+		{"4011780000000006", Elo, true},
+		{"6500310000000005", Elo, true},
+		{"9792000000000003", Troy, true},
+		{"9111111111111111", "", false},
+	}
+	for _, test := range tests {
+		brand, ok := CardBrand(test.param)
+		if brand != test.brand || ok != test.ok {
+			t.Errorf("Expected CardBrand(%q) to be %q, %v, got %q, %v", test.param, test.brand, test.ok, brand, ok)
+		}
+	}
+}
+
+func TestCreditCardBrands(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"2221000000000009", true},
+		{"2221000000000008", false},
+		{"4000000000000000006", true},
+		{"6205500000000000004", true},
+		{"2200000000000004", true},
+		{"9792000000000003", true},
+		{"5018 0000 0009", true},
+	}
+	for _, test := range tests {
+		actual := CreditCard(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected CreditCard(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestCardCVV(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		brand    Brand
+		param    string
+		expected bool
+	}{
+		{Visa, "123", true},
+		{Visa, "1234", false},
+		{Visa, "12", false},
+		{Visa, "12a", false},
+		{Amex, "1234", true},
+		{Amex, "123", false},
+		{Mastercard, "000", true},
+		{Brand("unknown"), "123", false},
+		{"", "123", false},
+	}
+	for _, test := range tests {
+		actual := CardCVV(test.brand, test.param)
+		if actual != test.expected {
+			t.Errorf("Expected CardCVV(%q, %q) to be %v, got %v", test.brand, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestCardExpiry(t *testing.T) {
+	t.Parallel()
+
+	now := time.Date(2026, time.March, 31, 23, 59, 0, 0, time.UTC)
+	var tests = []struct {
+		mm, yy   string
+		expected bool
+	}{
+		{"03", "26", true},
+		{"3", "26", true},
+		{"03", "2026", true},
+		{"02", "26", false},
+		{"12", "25", false},
+		{"04", "26", true},
+		{"01", "27", true},
+		{"13", "27", false},
+		{"00", "27", false},
+		{"", "27", false},
+		{"012", "27", false},
+		{"01", "7", false},
+		{"01", "027", false},
+		{"0a", "27", false},
+	}
+	for _, test := range tests {
+		actual := CardExpiry(test.mm, test.yy, now)
+		if actual != test.expected {
+			t.Errorf("Expected CardExpiry(%q, %q) to be %v, got %v", test.mm, test.yy, test.expected, actual)
+		}
+	}
+}
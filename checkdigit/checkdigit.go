@@ -0,0 +1,385 @@
+// Package checkdigit implements check digit algorithms used by identification
+// numbers: Luhn, Verhoeff, Damm, the ISO 7064 systems MOD 11-2, MOD 37-2,
+// MOD 97-10 and MOD 11,10, GS1 mod 10 and weighted sum mod 11.
+//
+// Each algorithm is a type whose zero value is ready to use. Validate reports
+// whether a string ends with its valid check characters and Compute returns
+// the check characters to append to a payload:
+//
+//	check, err := checkdigit.Luhn{}.Compute("7992739871") // "3"
+//	ok := checkdigit.Luhn{}.Validate("79927398713")       // true
+//
+// Validate never allocates.
+package checkdigit
+
+import "errors"
+
+// ErrInvalidChar is returned by Compute when the payload contains a character
+// the algorithm does not accept.
+var ErrInvalidChar = errors.New("checkdigit: invalid character")
+
+// Algorithm is implemented by every algorithm of the package.
+type Algorithm interface {
+	// Validate reports whether digits is a payload followed by its check
+	// characters.
+	Validate(digits string) bool
+	// Compute returns the check characters of payload.
+	Compute(payload string) (string, error)
+}
+
+var (
+	_ Algorithm = Luhn{}
+	_ Algorithm = Verhoeff{}
+	_ Algorithm = Damm{}
+	_ Algorithm = Mod11Radix2{}
+	_ Algorithm = Mod37Radix2{}
+	_ Algorithm = Mod97Radix10{}
+	_ Algorithm = Mod11Hybrid10{}
+	_ Algorithm = GS1{}
+	_ Algorithm = WeightedMod11{}
+)
+
+const (
+	decimal = "0123456789"
+	mod11   = "0123456789X"
+	mod37   = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZ*"
+)
+
+// single implements Validate for the algorithms with a single check
+// character: it reports whether digits ends with alphabet[c], where c is the
+// check computed for the rest of digits, or -1 for an invalid payload.
+func single(digits, alphabet string, c int) bool {
+	return c >= 0 && digits[len(digits)-1] == alphabet[c]
+}
+
+// result implements Compute for the algorithms with a single check character.
+func result(alphabet string, c int) (string, error) {
+	if c < 0 {
+		return "", ErrInvalidChar
+	}
+	return alphabet[c : c+1], nil
+}
+
+// Luhn is the Luhn algorithm, also known as mod 10, of payment card numbers,
+// IMEI and many national identifiers. It detects any single digit error and
+// most transpositions of adjacent digits.
+type Luhn struct{}
+
+// Validate reports whether digits ends with a valid Luhn check digit.
+func (l Luhn) Validate(digits string) bool {
+	return digits != "" && single(digits, decimal, l.check(digits[:len(digits)-1]))
+}
+
+// Compute returns the Luhn check digit of payload.
+func (l Luhn) Compute(payload string) (string, error) {
+	return result(decimal, l.check(payload))
+}
+
+func (Luhn) check(payload string) int {
+	sum := 0
+	// the digits are doubled starting from the rightmost one of the payload
+	double := true
+	for i := len(payload) - 1; i >= 0; i-- {
+		d := int(payload[i] - '0')
+		if d > 9 {
+			return -1
+		}
+		if double {
+			d *= 2
+			if d > 9 {
+				d -= 9
+			}
+		}
+		sum += d
+		double = !double
+	}
+	return (10 - sum%10) % 10
+}
+
+// verhoeffD is the multiplication table of the dihedral group D5.
+var verhoeffD = [10][10]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 2, 3, 4, 0, 6, 7, 8, 9, 5},
+	{2, 3, 4, 0, 1, 7, 8, 9, 5, 6},
+	{3, 4, 0, 1, 2, 8, 9, 5, 6, 7},
+	{4, 0, 1, 2, 3, 9, 5, 6, 7, 8},
+	{5, 9, 8, 7, 6, 0, 4, 3, 2, 1},
+	{6, 5, 9, 8, 7, 1, 0, 4, 3, 2},
+	{7, 6, 5, 9, 8, 2, 1, 0, 4, 3},
+	{8, 7, 6, 5, 9, 3, 2, 1, 0, 4},
+	{9, 8, 7, 6, 5, 4, 3, 2, 1, 0},
+}
+
+// verhoeffP is the permutation table of the Verhoeff algorithm.
+var verhoeffP = [8][10]byte{
+	{0, 1, 2, 3, 4, 5, 6, 7, 8, 9},
+	{1, 5, 7, 6, 2, 8, 3, 0, 9, 4},
+	{5, 8, 0, 3, 7, 9, 6, 1, 4, 2},
+	{8, 9, 1, 6, 0, 4, 3, 5, 2, 7},
+	{9, 4, 5, 3, 1, 2, 6, 8, 7, 0},
+	{4, 2, 8, 6, 5, 7, 3, 9, 0, 1},
+	{2, 7, 9, 3, 8, 0, 6, 4, 1, 5},
+	{7, 0, 4, 6, 9, 1, 3, 2, 5, 8},
+}
+
+// verhoeffInv holds the inverses in D5.
+var verhoeffInv = [10]byte{0, 4, 3, 2, 1, 5, 6, 7, 8, 9}
+
+// Verhoeff is the Verhoeff algorithm, based on the dihedral group D5, of
+// Aadhaar numbers among others. It detects all single digit errors and all
+// transpositions of adjacent digits.
+type Verhoeff struct{}
+
+// Validate reports whether digits ends with a valid Verhoeff check digit.
+func (v Verhoeff) Validate(digits string) bool {
+	return digits != "" && single(digits, decimal, v.check(digits[:len(digits)-1]))
+}
+
+// Compute returns the Verhoeff check digit of payload.
+func (v Verhoeff) Compute(payload string) (string, error) {
+	return result(decimal, v.check(payload))
+}
+
+func (Verhoeff) check(payload string) int {
+	c := byte(0)
+	for i := 0; i < len(payload); i++ {
+		d := payload[len(payload)-1-i] - '0'
+		if d > 9 {
+			return -1
+		}
+		// the check digit takes position 0
+		c = verhoeffD[c][verhoeffP[(i+1)%8][d]]
+	}
+	return int(verhoeffInv[c])
+}
+
+// dammTable is the totally anti-symmetric quasigroup of order 10 of the Damm
+// algorithm.
+var dammTable = [10][10]byte{
+	{0, 3, 1, 7, 5, 9, 8, 6, 4, 2},
+	{7, 0, 9, 2, 1, 5, 4, 8, 6, 3},
+	{4, 2, 0, 6, 8, 7, 1, 3, 5, 9},
+	{1, 7, 5, 0, 9, 8, 3, 4, 2, 6},
+	{6, 1, 2, 3, 0, 4, 5, 9, 7, 8},
+	{3, 6, 7, 4, 2, 0, 9, 5, 8, 1},
+	{5, 8, 6, 9, 7, 2, 0, 1, 3, 4},
+	{8, 9, 4, 5, 3, 6, 2, 0, 1, 7},
+	{9, 4, 3, 8, 6, 1, 7, 2, 0, 5},
+	{2, 5, 8, 1, 4, 3, 6, 7, 9, 0},
+}
+
+// Damm is the Damm algorithm. Like Verhoeff it detects all single digit
+// errors and adjacent transpositions, with a single lookup table.
+type Damm struct{}
+
+// Validate reports whether digits ends with a valid Damm check digit.
+func (d Damm) Validate(digits string) bool {
+	return digits != "" && single(digits, decimal, d.check(digits[:len(digits)-1]))
+}
+
+// Compute returns the Damm check digit of payload.
+func (d Damm) Compute(payload string) (string, error) {
+	return result(decimal, d.check(payload))
+}
+
+func (Damm) check(payload string) int {
+	c := byte(0)
+	for i := 0; i < len(payload); i++ {
+		d := payload[i] - '0'
+		if d > 9 {
+			return -1
+		}
+		c = dammTable[c][d]
+	}
+	return int(c)
+}
+
+// pure computes the check of a pure ISO 7064 system with a single check
+// character: the value making the weighted sum of the whole string congruent
+// to 1 modulo m, where payload characters are valued by their index in
+// alphabet.
+func pure(payload, alphabet string, m, radix int) int {
+	p := 0
+	for i := 0; i < len(payload); i++ {
+		v := indexOf(alphabet, payload[i])
+		if v < 0 {
+			return -1
+		}
+		p = (p + v) * radix % m
+	}
+	return (m + 1 - p) % m
+}
+
+// indexOf returns the index of c in alphabet, or -1.
+func indexOf(alphabet string, c byte) int {
+	for i := 0; i < len(alphabet); i++ {
+		if alphabet[i] == c {
+			return i
+		}
+	}
+	return -1
+}
+
+// Mod11Radix2 is ISO 7064 MOD 11-2, used by ISNI and ORCID identifiers and
+// Chinese resident identity cards. The payload is made of digits and the
+// check character is a digit or X.
+type Mod11Radix2 struct{}
+
+// Validate reports whether digits ends with a valid MOD 11-2 check character.
+func (Mod11Radix2) Validate(digits string) bool {
+	return digits != "" && single(digits, mod11, pure(digits[:len(digits)-1], decimal, 11, 2))
+}
+
+// Compute returns the MOD 11-2 check character of payload.
+func (Mod11Radix2) Compute(payload string) (string, error) {
+	return result(mod11, pure(payload, decimal, 11, 2))
+}
+
+// Mod37Radix2 is ISO 7064 MOD 37-2 for alphanumeric strings. The payload is
+// made of digits and upper case letters and the check character is a digit,
+// a letter or *.
+type Mod37Radix2 struct{}
+
+// Validate reports whether digits ends with a valid MOD 37-2 check character.
+func (Mod37Radix2) Validate(digits string) bool {
+	return digits != "" && single(digits, mod37, pure(digits[:len(digits)-1], mod37[:36], 37, 2))
+}
+
+// Compute returns the MOD 37-2 check character of payload.
+func (Mod37Radix2) Compute(payload string) (string, error) {
+	return result(mod37, pure(payload, mod37[:36], 37, 2))
+}
+
+// Mod97Radix10 is ISO 7064 MOD 97-10 with two check digits, as used by IBAN
+// and LEI. Upper case letters in the payload count as the numbers 10 to 35,
+// which is how IBAN and LEI apply it to alphanumeric strings.
+type Mod97Radix10 struct{}
+
+// Validate reports whether digits, read as a number, is 1 modulo 97, which is
+// the case when it ends with valid MOD 97-10 check digits.
+func (Mod97Radix10) Validate(digits string) bool {
+	return len(digits) > 2 && Mod97Radix10{}.remainder(digits) == 1
+}
+
+// Compute returns the two MOD 97-10 check digits of payload.
+func (Mod97Radix10) Compute(payload string) (string, error) {
+	r := Mod97Radix10{}.remainder(payload)
+	if r < 0 {
+		return "", ErrInvalidChar
+	}
+	c := 98 - r*100%97
+	return string([]byte{decimal[c/10], decimal[c%10]}), nil
+}
+
+// remainder returns str modulo 97, or -1 if str has an invalid character.
+func (Mod97Radix10) remainder(str string) int {
+	r := 0
+	for i := 0; i < len(str); i++ {
+		switch c := str[i]; {
+		case '0' <= c && c <= '9':
+			r = (r*10 + int(c-'0')) % 97
+		case 'A' <= c && c <= 'Z':
+			r = (r*100 + int(c-'A') + 10) % 97
+		default:
+			return -1
+		}
+	}
+	return r
+}
+
+// Mod11Hybrid10 is the ISO 7064 hybrid system MOD 11,10 of German and
+// Croatian tax numbers, among others.
+type Mod11Hybrid10 struct{}
+
+// Validate reports whether digits ends with a valid MOD 11,10 check digit.
+func (h Mod11Hybrid10) Validate(digits string) bool {
+	return digits != "" && single(digits, decimal, h.check(digits[:len(digits)-1]))
+}
+
+// Compute returns the MOD 11,10 check digit of payload.
+func (h Mod11Hybrid10) Compute(payload string) (string, error) {
+	return result(decimal, h.check(payload))
+}
+
+func (Mod11Hybrid10) check(payload string) int {
+	p := 10
+	for i := 0; i < len(payload); i++ {
+		d := int(payload[i] - '0')
+		if d > 9 {
+			return -1
+		}
+		s := (d + p) % 10
+		if s == 0 {
+			s = 10
+		}
+		p = s * 2 % 11
+	}
+	return (11 - p) % 10
+}
+
+// GS1 is the GS1 mod 10 algorithm of GTIN, EAN, UPC, ISBN-13 and SSCC
+// numbers: from the right of the payload the digits are weighted 3 and 1 in
+// turn.
+type GS1 struct{}
+
+// Validate reports whether digits ends with a valid GS1 check digit.
+func (g GS1) Validate(digits string) bool {
+	return digits != "" && single(digits, decimal, g.check(digits[:len(digits)-1]))
+}
+
+// Compute returns the GS1 check digit of payload.
+func (g GS1) Compute(payload string) (string, error) {
+	return result(decimal, g.check(payload))
+}
+
+func (GS1) check(payload string) int {
+	sum := 0
+	for i := 0; i < len(payload); i++ {
+		d := int(payload[len(payload)-1-i] - '0')
+		if d > 9 {
+			return -1
+		}
+		if i%2 == 0 {
+			d *= 3
+		}
+		sum += d
+	}
+	return (10 - sum%10) % 10
+}
+
+// WeightedMod11 is the weighted sum modulo 11 algorithm of ISBN-10, ISSN and
+// many national identifiers. The payload digits are multiplied by Weights
+// from the right, repeating Weights as needed, and the check digit makes the
+// sum, with the check digit itself weighted 1, a multiple of 11. A check
+// value of 10 is written X.
+type WeightedMod11 struct {
+	// Weights lists the weights from the rightmost payload digit. If nil,
+	// the weights are 2, 3, 4 and so on, as in ISBN-10.
+	Weights []int
+}
+
+// Validate reports whether digits ends with a valid check digit or X.
+func (w WeightedMod11) Validate(digits string) bool {
+	return digits != "" && single(digits, mod11, w.check(digits[:len(digits)-1]))
+}
+
+// Compute returns the check digit of payload, which is X for 10.
+func (w WeightedMod11) Compute(payload string) (string, error) {
+	return result(mod11, w.check(payload))
+}
+
+func (w WeightedMod11) check(payload string) int {
+	sum := 0
+	for i := 0; i < len(payload); i++ {
+		d := int(payload[len(payload)-1-i] - '0')
+		if d > 9 {
+			return -1
+		}
+		if len(w.Weights) == 0 {
+			sum += d * (i + 2)
+		} else {
+			sum += d * w.Weights[i%len(w.Weights)]
+		}
+	}
+	return (11 - sum%11) % 11
+}
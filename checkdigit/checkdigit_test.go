@@ -0,0 +1,109 @@
+package checkdigit
+
+import "testing"
+
+var vectors = []struct {
+	name      string
+	algorithm Algorithm
+	payload   string
+	check     string
+}{
+	{"Luhn", Luhn{}, "7992739871", "3"},
+	{"Luhn", Luhn{}, "411111111111111", "1"},
+	{"Luhn", Luhn{}, "", "0"},
+	{"Verhoeff", Verhoeff{}, "236", "3"},
+	{"Verhoeff", Verhoeff{}, "23412341234", "6"},
+	{"Damm", Damm{}, "572", "4"},
+	{"Mod11Radix2", Mod11Radix2{}, "000000021825009", "7"},
+	{"Mod11Radix2", Mod11Radix2{}, "11010519491231002", "X"},
+	{"Mod37Radix2", Mod37Radix2{}, "G123489654321", "Y"},
+	{"Mod97Radix10", Mod97Radix10{}, "WEST12345698765432GB", "82"},
+	{"Mod97Radix10", Mod97Radix10{}, "5493001KJTIIGC8Y1R", "12"},
+	{"Mod11Hybrid10", Mod11Hybrid10{}, "13669597", "6"},
+	{"Mod11Hybrid10", Mod11Hybrid10{}, "3339200596", "1"},
+	{"GS1", GS1{}, "400638133393", "1"},
+	{"GS1", GS1{}, "978030640615", "7"},
+	{"WeightedMod11", WeightedMod11{}, "030640615", "2"},
+	{"WeightedMod11", WeightedMod11{}, "080442957", "X"},
+	{"WeightedMod11", WeightedMod11{}, "0317847", "1"},
+	{"WeightedMod11", WeightedMod11{Weights: []int{2, 3, 4, 5, 6, 7}}, "123456789", "2"},
+}
+
+func TestCompute(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range vectors {
+		if actual, err := test.algorithm.Compute(test.payload); err != nil || actual != test.check {
+			t.Errorf("Expected %s.Compute(%q) to be %q, got %q, %v", test.name, test.payload, test.check, actual, err)
+		}
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+
+	for _, test := range vectors {
+		digits := test.payload + test.check
+		if !test.algorithm.Validate(digits) {
+			t.Errorf("Expected %s.Validate(%q) to be true", test.name, digits)
+		}
+		// every single digit error of the payload is detected
+		for i := 0; i < len(test.payload); i++ {
+			c := test.payload[i]
+			if c < '0' || c > '9' {
+				continue
+			}
+			wrong := []byte(digits)
+			wrong[i] = '0' + (c-'0'+1)%10
+			if test.algorithm.Validate(string(wrong)) {
+				t.Errorf("Expected %s.Validate(%q) to be false", test.name, wrong)
+			}
+		}
+	}
+}
+
+func TestInvalidChar(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name      string
+		algorithm Algorithm
+		payload   string
+	}{
+		{"Luhn", Luhn{}, "7992 739871"},
+		{"Verhoeff", Verhoeff{}, "23a"},
+		{"Damm", Damm{}, "57-2"},
+		{"Mod11Radix2", Mod11Radix2{}, "0X1"},
+		{"Mod37Radix2", Mod37Radix2{}, "G1234*"},
+		{"Mod97Radix10", Mod97Radix10{}, "west"},
+		{"Mod11Hybrid10", Mod11Hybrid10{}, "1366959A"},
+		{"GS1", GS1{}, "40063813339/"},
+		{"WeightedMod11", WeightedMod11{}, "03064061X"},
+	}
+	for _, test := range tests {
+		if _, err := test.algorithm.Compute(test.payload); err != ErrInvalidChar {
+			t.Errorf("Expected %s.Compute(%q) to fail with ErrInvalidChar, got %v", test.name, test.payload, err)
+		}
+		if test.algorithm.Validate(test.payload + "0") {
+			t.Errorf("Expected %s.Validate(%q) to be false", test.name, test.payload+"0")
+		}
+	}
+	for _, a := range []Algorithm{Luhn{}, Verhoeff{}, Damm{}, Mod11Radix2{}, Mod37Radix2{}, Mod97Radix10{}, Mod11Hybrid10{}, GS1{}, WeightedMod11{}} {
+		if a.Validate("") {
+			t.Errorf("Expected %T.Validate(\"\") to be false", a)
+		}
+	}
+}
+
+func TestValidateZeroAllocs(t *testing.T) {
+	var digits [16]byte
+	copy(digits[:], "4111111111111111")
+	allocs := testing.AllocsPerRun(100, func() {
+		Luhn{}.Validate(string(digits[:]))
+		GS1{}.Validate(string(digits[:13]))
+		WeightedMod11{}.Validate(string(digits[:10]))
+	})
+	if allocs != 0 {
+		t.Errorf("Expected Validate to make 0 allocs/op, got %v", allocs)
+	}
+}
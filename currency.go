@@ -0,0 +1,232 @@
+package is
+
+// ISO4217Entry stores currency codes
+type ISO4217Entry struct {
+	Currency       string
+	AlphabeticCode string
+	NumericCode    string
+	// MinorUnit is the number of digits after the decimal separator, or -1
+	// where ISO 4217 gives none, as for gold (XAU) and other funds.
+	MinorUnit int
+}
+
+// ISO4217List based on https://www.iso.org/iso-4217-currency-codes.html List one "Current currency & funds"
+var ISO4217List = []ISO4217Entry{
+	{"UAE Dirham", "AED", "784", 2},
+	{"Afghani", "AFN", "971", 2},
+	{"Lek", "ALL", "008", 2},
+	{"Armenian Dram", "AMD", "051", 2},
+	{"Netherlands Antillean Guilder", "ANG", "532", 2},
+	{"Kwanza", "AOA", "973", 2},
+	{"Argentine Peso", "ARS", "032", 2},
+	{"Australian Dollar", "AUD", "036", 2},
+	{"Aruban Florin", "AWG", "533", 2},
+	{"Azerbaijan Manat", "AZN", "944", 2},
+	{"Convertible Mark", "BAM", "977", 2},
+	{"Barbados Dollar", "BBD", "052", 2},
+	{"Taka", "BDT", "050", 2},
+	{"Bulgarian Lev", "BGN", "975", 2},
+	{"Bahraini Dinar", "BHD", "048", 3},
+	{"Burundi Franc", "BIF", "108", 0},
+	{"Bermudian Dollar", "BMD", "060", 2},
+	{"Brunei Dollar", "BND", "096", 2},
+	{"Boliviano", "BOB", "068", 2},
+	{"Mvdol", "BOV", "984", 2},
+	{"Brazilian Real", "BRL", "986", 2},
+	{"Bahamian Dollar", "BSD", "044", 2},
+	{"Ngultrum", "BTN", "064", 2},
+	{"Pula", "BWP", "072", 2},
+	{"Belarusian Ruble", "BYN", "933", 2},
+	{"Belize Dollar", "BZD", "084", 2},
+	{"Canadian Dollar", "CAD", "124", 2},
+	{"Congolese Franc", "CDF", "976", 2},
+	{"WIR Euro", "CHE", "947", 2},
+	{"Swiss Franc", "CHF", "756", 2},
+	{"WIR Franc", "CHW", "948", 2},
+	{"Unidad de Fomento", "CLF", "990", 4},
+	{"Chilean Peso", "CLP", "152", 0},
+	{"Yuan Renminbi", "CNY", "156", 2},
+	{"Colombian Peso", "COP", "170", 2},
+	{"Unidad de Valor Real", "COU", "970", 2},
+	{"Costa Rican Colon", "CRC", "188", 2},
+	{"Peso Convertible", "CUC", "931", 2},
+	{"Cuban Peso", "CUP", "192", 2},
+	{"Cabo Verde Escudo", "CVE", "132", 2},
+	{"Czech Koruna", "CZK", "203", 2},
+	{"Djibouti Franc", "DJF", "262", 0},
+	{"Danish Krone", "DKK", "208", 2},
+	{"Dominican Peso", "DOP", "214", 2},
+	{"Algerian Dinar", "DZD", "012", 2},
+	{"Egyptian Pound", "EGP", "818", 2},
+	{"Nakfa", "ERN", "232", 2},
+	{"Ethiopian Birr", "ETB", "230", 2},
+	{"Euro", "EUR", "978", 2},
+	{"Fiji Dollar", "FJD", "242", 2},
+	{"Falkland Islands Pound", "FKP", "238", 2},
+	{"Pound Sterling", "GBP", "826", 2},
+	{"Lari", "GEL", "981", 2},
+	{"Ghana Cedi", "GHS", "936", 2},
+	{"Gibraltar Pound", "GIP", "292", 2},
+	{"Dalasi", "GMD", "270", 2},
+	{"Guinean Franc", "GNF", "324", 0},
+	{"Quetzal", "GTQ", "320", 2},
+	{"Guyana Dollar", "GYD", "328", 2},
+	{"Hong Kong Dollar", "HKD", "344", 2},
+	{"Lempira", "HNL", "340", 2},
+	{"Gourde", "HTG", "332", 2},
+	{"Forint", "HUF", "348", 2},
+	{"Rupiah", "IDR", "360", 2},
+	{"New Israeli Sheqel", "ILS", "376", 2},
+	{"Indian Rupee", "INR", "356", 2},
+	{"Iraqi Dinar", "IQD", "368", 3},
+	{"Iranian Rial", "IRR", "364", 2},
+	{"Iceland Krona", "ISK", "352", 0},
+	{"Jamaican Dollar", "JMD", "388", 2},
+	{"Jordanian Dinar", "JOD", "400", 3},
+	{"Yen", "JPY", "392", 0},
+	{"Kenyan Shilling", "KES", "404", 2},
+	{"Som", "KGS", "417", 2},
+	{"Riel", "KHR", "116", 2},
+	{"Comorian Franc", "KMF", "174", 0},
+	{"North Korean Won", "KPW", "408", 2},
+	{"Won", "KRW", "410", 0},
+	{"Kuwaiti Dinar", "KWD", "414", 3},
+	{"Cayman Islands Dollar", "KYD", "136", 2},
+	{"Tenge", "KZT", "398", 2},
+	{"Lao Kip", "LAK", "418", 2},
+	{"Lebanese Pound", "LBP", "422", 2},
+	{"Sri Lanka Rupee", "LKR", "144", 2},
+	{"Liberian Dollar", "LRD", "430", 2},
+	{"Loti", "LSL", "426", 2},
+	{"Libyan Dinar", "LYD", "434", 3},
+	{"Moroccan Dirham", "MAD", "504", 2},
+	{"Moldovan Leu", "MDL", "498", 2},
+	{"Malagasy Ariary", "MGA", "969", 2},
+	{"Denar", "MKD", "807", 2},
+	{"Kyat", "MMK", "104", 2},
+	{"Tugrik", "MNT", "496", 2},
+	{"Pataca", "MOP", "446", 2},
+	{"Ouguiya", "MRU", "929", 2},
+	{"Mauritius Rupee", "MUR", "480", 2},
+	{"Rufiyaa", "MVR", "462", 2},
+	{"Malawi Kwacha", "MWK", "454", 2},
+	{"Mexican Peso", "MXN", "484", 2},
+	{"Mexican Unidad de Inversion (UDI)", "MXV", "979", 2},
+	{"Malaysian Ringgit", "MYR", "458", 2},
+	{"Mozambique Metical", "MZN", "943", 2},
+	{"Namibia Dollar", "NAD", "516", 2},
+	{"Naira", "NGN", "566", 2},
+	{"Cordoba Oro", "NIO", "558", 2},
+	{"Norwegian Krone", "NOK", "578", 2},
+	{"Nepalese Rupee", "NPR", "524", 2},
+	{"New Zealand Dollar", "NZD", "554", 2},
+	{"Rial Omani", "OMR", "512", 3},
+	{"Balboa", "PAB", "590", 2},
+	{"Sol", "PEN", "604", 2},
+	{"Kina", "PGK", "598", 2},
+	{"Philippine Peso", "PHP", "608", 2},
+	{"Pakistan Rupee", "PKR", "586", 2},
+	{"Zloty", "PLN", "985", 2},
+	{"Guarani", "PYG", "600", 0},
+	{"Qatari Rial", "QAR", "634", 2},
+	{"Romanian Leu", "RON", "946", 2},
+	{"Serbian Dinar", "RSD", "941", 2},
+	{"Russian Ruble", "RUB", "643", 2},
+	{"Rwanda Franc", "RWF", "646", 0},
+	{"Saudi Riyal", "SAR", "682", 2},
+	{"Solomon Islands Dollar", "SBD", "090", 2},
+	{"Seychelles Rupee", "SCR", "690", 2},
+	{"Sudanese Pound", "SDG", "938", 2},
+	{"Swedish Krona", "SEK", "752", 2},
+	{"Singapore Dollar", "SGD", "702", 2},
+	{"Saint Helena Pound", "SHP", "654", 2},
+	{"Leone", "SLE", "925", 2},
+	{"Leone", "SLL", "694", 2},
+	{"Somali Shilling", "SOS", "706", 2},
+	{"Surinam Dollar", "SRD", "968", 2},
+	{"South Sudanese Pound", "SSP", "728", 2},
+	{"Dobra", "STN", "930", 2},
+	{"El Salvador Colon", "SVC", "222", 2},
+	{"Syrian Pound", "SYP", "760", 2},
+	{"Lilangeni", "SZL", "748", 2},
+	{"Baht", "THB", "764", 2},
+	{"Somoni", "TJS", "972", 2},
+	{"Turkmenistan New Manat", "TMT", "934", 2},
+	{"Tunisian Dinar", "TND", "788", 3},
+	{"Pa’anga", "TOP", "776", 2},
+	{"Turkish Lira", "TRY", "949", 2},
+	{"Trinidad and Tobago Dollar", "TTD", "780", 2},
+	{"New Taiwan Dollar", "TWD", "901", 2},
+	{"Tanzanian Shilling", "TZS", "834", 2},
+	{"Hryvnia", "UAH", "980", 2},
+	{"Uganda Shilling", "UGX", "800", 0},
+	{"US Dollar", "USD", "840", 2},
+	{"US Dollar (Next day)", "USN", "997", 2},
+	{"Uruguay Peso en Unidades Indexadas (UI)", "UYI", "940", 0},
+	{"Peso Uruguayo", "UYU", "858", 2},
+	{"Unidad Previsional", "UYW", "927", 4},
+	{"Uzbekistan Sum", "UZS", "860", 2},
+	{"Bolívar Soberano", "VED", "926", 2},
+	{"Bolívar Soberano", "VES", "928", 2},
+	{"Dong", "VND", "704", 0},
+	{"Vatu", "VUV", "548", 0},
+	{"Tala", "WST", "882", 2},
+	{"CFA Franc BEAC", "XAF", "950", 0},
+	{"Silver", "XAG", "961", -1},
+	{"Gold", "XAU", "959", -1},
+	{"Bond Markets Unit European Composite Unit (EURCO)", "XBA", "955", -1},
+	{"Bond Markets Unit European Monetary Unit (E.M.U.-6)", "XBB", "956", -1},
+	{"Bond Markets Unit European Unit of Account 9 (E.U.A.-9)", "XBC", "957", -1},
+	{"Bond Markets Unit European Unit of Account 17 (E.U.A.-17)", "XBD", "958", -1},
+	{"East Caribbean Dollar", "XCD", "951", 2},
+	{"Caribbean Guilder", "XCG", "532", 2},
+	{"SDR (Special Drawing Right)", "XDR", "960", -1},
+	{"CFA Franc BCEAO", "XOF", "952", 0},
+	{"Palladium", "XPD", "964", -1},
+	{"CFP Franc", "XPF", "953", 0},
+	{"Platinum", "XPT", "962", -1},
+	{"Sucre", "XSU", "994", -1},
+	{"Codes specifically reserved for testing purposes", "XTS", "963", -1},
+	{"ADB Unit of Account", "XUA", "965", -1},
+	{"The codes assigned for transactions where no currency is involved", "XXX", "999", -1},
+	{"Yemeni Rial", "YER", "886", 2},
+	{"Rand", "ZAR", "710", 2},
+	{"Zambian Kwacha", "ZMW", "967", 2},
+	{"Zimbabwe Gold", "ZWG", "924", 2},
+}
+
+// ISO4217 check if the string is an alphabetic currency code, e.g. EUR.
+func ISO4217(str string) bool {
+	return iso4217Entry(str) != nil
+}
+
+// ISO4217Numeric check if the string is a numeric currency code, e.g. 978.
+func ISO4217Numeric(str string) bool {
+	for _, entry := range ISO4217List {
+		if str == entry.NumericCode {
+			return true
+		}
+	}
+	return false
+}
+
+// CurrencyMinorUnit returns the number of decimal digits of amounts in the
+// currency with the given alphabetic code, e.g. 2 for EUR and 0 for JPY. It
+// returns false for unknown codes and for codes without a minor unit.
+func CurrencyMinorUnit(code string) (int, bool) {
+	e := iso4217Entry(code)
+	if e == nil || e.MinorUnit < 0 {
+		return 0, false
+	}
+	return e.MinorUnit, true
+}
+
+// iso4217Entry returns the ISO4217List entry of the alphabetic code, or nil.
+func iso4217Entry(code string) *ISO4217Entry {
+	for i := range ISO4217List {
+		if ISO4217List[i].AlphabeticCode == code {
+			return &ISO4217List[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,75 @@
+package is
+
+import "testing"
+
+func TestISO4217(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"EUR", true},
+		{"USD", true},
+		{"JPY", true},
+		{"XAU", true},
+		{"ZWG", true},
+		{"eur", false},
+		{"EU", false},
+		{"EURO", false},
+		{"HRK", false},
+		{"ABC", false},
+	}
+	for _, test := range tests {
+		if actual := ISO4217(test.param); actual != test.expected {
+			t.Errorf("Expected ISO4217(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestISO4217Numeric(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"978", true},
+		{"840", true},
+		{"008", true},
+		{"8", false},
+		{"999", true},
+		{"000", false},
+		{"191", false},
+	}
+	for _, test := range tests {
+		if actual := ISO4217Numeric(test.param); actual != test.expected {
+			t.Errorf("Expected ISO4217Numeric(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestCurrencyMinorUnit(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected int
+		ok       bool
+	}{
+		{"EUR", 2, true},
+		{"JPY", 0, true},
+		{"KWD", 3, true},
+		{"CLF", 4, true},
+		{"XAU", 0, false},
+		{"ABC", 0, false},
+	}
+	for _, test := range tests {
+		actual, ok := CurrencyMinorUnit(test.param)
+		if actual != test.expected || ok != test.ok {
+			t.Errorf("Expected CurrencyMinorUnit(%q) to be %d, %v, got %d, %v", test.param, test.expected, test.ok, actual, ok)
+		}
+	}
+}
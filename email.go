@@ -0,0 +1,197 @@
+package is
+
+import (
+	"net"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// Length limits of RFC 5321 section 4.5.3.1 and RFC 3696 errata 1690.
+const (
+	emailMaxLocal  = 64
+	emailMaxDomain = 255
+	emailMaxLength = 254
+)
+
+// EmailAddress is an email address split into its parts.
+type EmailAddress struct {
+	// Local is the local part as written, including the quotes of a quoted local part.
+	Local string
+	// Domain is the domain name, or the domain literal including its brackets.
+	Domain string
+	// Tag is the sub-address after the first "+" of an unquoted local part, if any.
+	Tag string
+}
+
+// String returns the address in the form local@domain.
+func (a EmailAddress) String() string {
+	return a.Local + "@" + a.Domain
+}
+
+// EmailOptions restricts the address forms accepted by ParseEmailWith.
+// The zero value accepts everything RFC 5321 allows.
+type EmailOptions struct {
+	// DisallowQuoted rejects quoted local parts such as "john doe"@example.com.
+	DisallowQuoted bool
+	// DisallowIPLiteral rejects domain literals such as user@[192.0.2.1].
+	DisallowIPLiteral bool
+}
+
+// EmailWith check if the string is an email address accepted by opts.
+func EmailWith(s string, opts EmailOptions) bool {
+	_, err := ParseEmailWith(s, opts)
+	return err == nil
+}
+
+// ParseEmail parses an RFC 5321 mailbox such as john.doe+news@example.com.
+// The local part may be a dot-atom or a quoted string, and the domain may be a
+// host name or an address literal ([192.0.2.1], [IPv6:2001:db8::1]).
+// Internationalized (UTF-8) addresses are accepted as described in RFC 6531.
+// On failure the error is a *ValidationError.
+func ParseEmail(s string) (EmailAddress, error) {
+	return ParseEmailWith(s, EmailOptions{})
+}
+
+// ParseEmailWith is like ParseEmail but applies the restrictions of opts.
+func ParseEmailWith(s string, opts EmailOptions) (EmailAddress, error) {
+	var addr EmailAddress
+	switch {
+	case s == "":
+		return addr, invalid("Email", CodeEmpty, -1)
+	case len(s) > emailMaxLength:
+		return addr, invalid("Email", CodeTooLong, -1)
+	case !utf8.ValidString(s):
+		return addr, invalid("Email", CodeInvalidChar, -1)
+	}
+
+	var at int
+	var err error
+	if s[0] == '"' {
+		if opts.DisallowQuoted {
+			return addr, invalid("Email", CodeNotAllowed, 0)
+		}
+		at, err = scanQuotedLocal(s)
+	} else {
+		at, err = scanDotAtomLocal(s)
+	}
+	if err != nil {
+		return addr, err
+	}
+	if at == len(s) {
+		return addr, invalid("Email", CodeBadFormat, -1)
+	}
+	if at > emailMaxLocal {
+		return addr, invalid("Email", CodeTooLong, 0)
+	}
+
+	addr.Local = s[:at]
+	addr.Domain = s[at+1:]
+	if s[0] != '"' {
+		if i := strings.IndexByte(addr.Local, '+'); i >= 0 {
+			addr.Tag = addr.Local[i+1:]
+		}
+	}
+
+	if strings.HasPrefix(addr.Domain, "[") {
+		if opts.DisallowIPLiteral {
+			return addr, invalid("Email", CodeNotAllowed, at+1)
+		}
+		return addr, checkEmailLiteral(addr.Domain, at+1)
+	}
+	return addr, checkEmailDomain(addr.Domain, at+1)
+}
+
+// scanDotAtomLocal returns the offset of the "@" that ends a dot-atom local part.
+func scanDotAtomLocal(s string) (int, error) {
+	for i, r := range s {
+		switch {
+		case r == '@':
+			if i == 0 {
+				return 0, invalid("Email", CodeBadFormat, 0)
+			}
+			if s[i-1] == '.' {
+				return 0, invalid("Email", CodeBadFormat, i-1)
+			}
+			return i, nil
+		case r == '.':
+			if i == 0 || s[i-1] == '.' {
+				return 0, invalid("Email", CodeBadFormat, i)
+			}
+		case !emailAtext(r):
+			return 0, invalid("Email", CodeInvalidChar, i)
+		}
+	}
+	return len(s), nil
+}
+
+// scanQuotedLocal returns the offset of the "@" that follows a quoted local part.
+func scanQuotedLocal(s string) (int, error) {
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; {
+		case c == '"':
+			if i+1 == len(s) || s[i+1] != '@' {
+				return 0, invalid("Email", CodeBadFormat, i)
+			}
+			return i + 1, nil
+		case c == '\\':
+			// quoted-pair: any printable ASCII character or space
+			i++
+			if i == len(s) || s[i] < ' ' || s[i] > '~' {
+				return 0, invalid("Email", CodeInvalidChar, i-1)
+			}
+		case c < ' ' || c == 0x7f:
+			return 0, invalid("Email", CodeInvalidChar, i)
+		}
+	}
+	// unterminated quoted string
+	return 0, invalid("Email", CodeBadFormat, -1)
+}
+
+// checkEmailLiteral validates an address literal such as [192.0.2.1] or [IPv6:::1]
+// found at offset off of the address.
+func checkEmailLiteral(d string, off int) error {
+	if len(d) < 2 || d[len(d)-1] != ']' {
+		return invalid("Email", CodeBadFormat, off)
+	}
+	lit := d[1 : len(d)-1]
+	if len(lit) > 5 && strings.EqualFold(lit[:5], "IPv6:") {
+		if !IPv6(lit[5:]) {
+			return invalid("Email", CodeBadFormat, off+6)
+		}
+		return nil
+	}
+	// without the tag only dotted IPv4 is allowed, not ::ffff:1.2.3.4
+	if strings.Contains(lit, ":") || net.ParseIP(lit).To4() == nil {
+		return invalid("Email", CodeBadFormat, off+1)
+	}
+	return nil
+}
+
+// checkEmailDomain validates the domain found at offset off of the address.
+// Labels are made of letters, digits and inner hyphens (RFC 5321 Ldh-str);
+// internationalized domains may use letters and digits of any script.
+func checkEmailDomain(d string, off int) error {
+	if d == "" {
+		// nothing after the "@"
+		return invalid("Email", CodeBadFormat, off-1)
+	}
+	if len(d) > emailMaxDomain {
+		return invalid("Email", CodeTooLong, off)
+	}
+	if code, offset := checkHostName(d); code != "" {
+		return invalid("Email", code, off+offset)
+	}
+	return nil
+}
+
+// emailAtext reports whether r may appear in a dot-atom (RFC 5322 atext, RFC 6531 UTF8-non-ascii).
+func emailAtext(r rune) bool {
+	switch {
+	case 'a' <= r && r <= 'z', 'A' <= r && r <= 'Z', '0' <= r && r <= '9':
+		return true
+	case r >= utf8.RuneSelf:
+		return unicode.IsGraphic(r) && !unicode.IsSpace(r)
+	}
+	return strings.ContainsRune("!#$%&'*+-/=?^_`{|}~", r)
+}
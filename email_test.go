@@ -0,0 +1,86 @@
+package is
+
+import "testing"
+
+func TestParseEmail(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected EmailAddress
+	}{
+		{`foo@bar.com`, EmailAddress{"foo", "bar.com", ""}},
+		{`foo+news@bar.com`, EmailAddress{"foo+news", "bar.com", "news"}},
+		{`foo+a+b@bar.com`, EmailAddress{"foo+a+b", "bar.com", "a+b"}},
+		{`"foo+bar"@bar.com`, EmailAddress{`"foo+bar"`, "bar.com", ""}},
+		{`"foo@bar"@[192.0.2.1]`, EmailAddress{`"foo@bar"`, "[192.0.2.1]", ""}},
+		{`hans@m端ller.com`, EmailAddress{"hans", "m端ller.com", ""}},
+	}
+	for _, test := range tests {
+		actual, err := ParseEmail(test.param)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParseEmail(%q) to be %#v, got %#v, %v", test.param, test.expected, actual, err)
+		}
+		if actual.String() != test.param {
+			t.Errorf("Expected ParseEmail(%q).String() to round-trip, got %q", test.param, actual.String())
+		}
+	}
+}
+
+func TestParseEmailErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{``, CodeEmpty, -1},
+		{`foo`, CodeBadFormat, -1},
+		{`a@@b`, CodeInvalidChar, 2},
+		{`a b@c.com`, CodeInvalidChar, 1},
+		{`foo..bar@bar.com`, CodeBadFormat, 4},
+		{`"foo\`, CodeInvalidChar, 4},
+		{`"foo" @bar.com`, CodeBadFormat, 4},
+		{`foo@bar.c*m`, CodeInvalidChar, 9},
+		{`foo@[IPv6:zz]`, CodeBadFormat, 10},
+		{`foo@[1.2.3.4`, CodeBadFormat, 4},
+		{`foo@[::ffff:1.2.3.4]`, CodeBadFormat, 5},
+		{`foo@[::1]`, CodeBadFormat, 5},
+		{`foo@a_b.com`, CodeInvalidChar, 5},
+		{`foo@bar.中文网-`, CodeInvalidChar, 17},
+	}
+	for _, test := range tests {
+		_, err := ParseEmail(test.param)
+		actual, ok := err.(*ValidationError)
+		if !ok || actual.Code != test.code || actual.Offset != test.offset {
+			t.Errorf("Expected ParseEmail(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestEmailWith(t *testing.T) {
+	t.Parallel()
+
+	strict := EmailOptions{DisallowQuoted: true, DisallowIPLiteral: true}
+	var tests = []struct {
+		param    string
+		opts     EmailOptions
+		expected bool
+	}{
+		{`foo@bar.com`, strict, true},
+		{`"foo bar"@bar.com`, EmailOptions{}, true},
+		{`"foo bar"@bar.com`, strict, false},
+		{`foo@[192.0.2.1]`, EmailOptions{DisallowQuoted: true}, true},
+		{`foo@[192.0.2.1]`, strict, false},
+		{`foo@[IPv6:::1]`, EmailOptions{DisallowIPLiteral: true}, false},
+		{`foo@[IPv6:::ffff:1.2.3.4]`, EmailOptions{}, true},
+		{`foo@[::ffff:1.2.3.4]`, EmailOptions{}, false},
+	}
+	for _, test := range tests {
+		actual := EmailWith(test.param, test.opts)
+		if actual != test.expected {
+			t.Errorf("Expected EmailWith(%q, %+v) to be %v, got %v", test.param, test.opts, test.expected, actual)
+		}
+	}
+}
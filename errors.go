@@ -0,0 +1,72 @@
+package is
+
+import "strconv"
+
+// Codes reported in ValidationError.Code. They are stable and safe to match on.
+const (
+	// CodeEmpty is reported for an empty input.
+	CodeEmpty = "empty"
+	// CodeTooShort is reported when the input has too few characters or digits.
+	CodeTooShort = "too_short"
+	// CodeTooLong is reported when the input, or a part of it, is too long.
+	CodeTooLong = "too_long"
+	// CodeBadLength is reported when the length is none of the allowed ones.
+	CodeBadLength = "bad_length"
+	// CodeInvalidChar is reported for a character that is not allowed at its position.
+	CodeInvalidChar = "invalid_char"
+	// CodeBadChecksum is reported when a check digit does not match.
+	CodeBadChecksum = "bad_checksum"
+	// CodeBadFormat is reported when the overall structure is wrong.
+	CodeBadFormat = "bad_format"
+	// CodeNotAllowed is reported for a well-formed value that the caller's options forbid.
+	CodeNotAllowed = "not_allowed"
+	// CodeUnknownCountry is reported for a country code that is not known or not supported.
+	CodeUnknownCountry = "unknown_country"
+	// CodeUnknownLanguage is reported for a language code that is not known.
+	CodeUnknownLanguage = "unknown_language"
+	// CodeNotCheckable is reported when the input cannot be fully checked
+	// with the data at hand.
+	CodeNotCheckable = "not_checkable"
+)
+
+// Codes reported by CheckURLWith for URLs that break a URLOptions policy.
+const (
+	// CodeBadScheme is reported for a missing or disallowed scheme.
+	CodeBadScheme = "bad_scheme"
+	// CodeMissingHost is reported when a host is required but absent.
+	CodeMissingHost = "missing_host"
+	// CodeMissingTLD is reported when the host has no top-level domain.
+	CodeMissingTLD = "missing_tld"
+	// CodeIPHost is reported when the host is an IP address but IPs are not allowed.
+	CodeIPHost = "ip_host"
+	// CodePrivateIP is reported when the host is localhost or an address that is not globally reachable.
+	CodePrivateIP = "private_ip"
+	// CodeUserinfo is reported when the URL carries a user name or password.
+	CodeUserinfo = "userinfo"
+	// CodeBadPort is reported for an invalid or disallowed port.
+	CodeBadPort = "bad_port"
+)
+
+// ValidationError describes why a value was rejected by one of the Check functions.
+type ValidationError struct {
+	// Validator is the name of the validator, e.g. "CreditCard".
+	Validator string
+	// Code is a machine-readable reason, one of the Code constants.
+	Code string
+	// Offset is the byte offset of the problem in the input, or -1 if
+	// the problem is not tied to a position.
+	Offset int
+}
+
+func (e *ValidationError) Error() string {
+	msg := "is: " + e.Validator + ": " + e.Code
+	if e.Offset >= 0 {
+		msg += " at offset " + strconv.Itoa(e.Offset)
+	}
+	return msg
+}
+
+// invalid returns a *ValidationError as an error.
+func invalid(validator, code string, offset int) error {
+	return &ValidationError{Validator: validator, Code: code, Offset: offset}
+}
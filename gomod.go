@@ -0,0 +1,250 @@
+package is
+
+import (
+	"strings"
+	"time"
+)
+
+// GoModulePath check if the string is a Go module path as accepted by the go
+// command, such as github.com/user/repo/v2:
+//
+//   - slash separated elements of ASCII letters, digits, hyphens, dots,
+//     underscores and tildes, not starting or ending with a dot
+//   - a first element of lower case letters, digits, dots and hyphens,
+//     containing a dot and not starting with a hyphen
+//   - no element named after a reserved Windows device (CON, PRN, AUX, NUL,
+//     COM1 to COM9, LPT1 to LPT9), whatever its case or extension, nor
+//     looking like a Windows short name (a tilde followed by digits)
+//   - a last element /vN only for a major version N of 2 or more, written
+//     without leading zeros, or for gopkg.in paths a .vN suffix, as in
+//     gopkg.in/yaml.v3
+func GoModulePath(str string) bool {
+	code, _ := goPath(str, true)
+	return code == ""
+}
+
+// CheckGoModulePath is like GoModulePath but returns a *ValidationError explaining why str was rejected.
+func CheckGoModulePath(str string) error {
+	if code, offset := goPath(str, true); code != "" {
+		return invalid("GoModulePath", code, offset)
+	}
+	return nil
+}
+
+// GoImportPath check if the string is a Go package import path, such as fmt
+// or github.com/user/repo/internal/pkg: slash separated elements of ASCII
+// letters, digits and the characters - . _ ~ and +, with the restrictions on
+// elements of GoModulePath. Unlike module paths, import paths need no dot in
+// their first element and have no major version rule.
+func GoImportPath(str string) bool {
+	code, _ := goPath(str, false)
+	return code == ""
+}
+
+// CheckGoImportPath is like GoImportPath but returns a *ValidationError explaining why str was rejected.
+func CheckGoImportPath(str string) error {
+	if code, offset := goPath(str, false); code != "" {
+		return invalid("GoImportPath", code, offset)
+	}
+	return nil
+}
+
+// goWindowsReserved are the file names reserved by Windows.
+var goWindowsReserved = []string{
+	"CON", "PRN", "AUX", "NUL",
+	"COM1", "COM2", "COM3", "COM4", "COM5", "COM6", "COM7", "COM8", "COM9",
+	"LPT1", "LPT2", "LPT3", "LPT4", "LPT5", "LPT6", "LPT7", "LPT8", "LPT9",
+}
+
+func goPath(str string, module bool) (string, int) {
+	if str == "" {
+		return CodeEmpty, -1
+	}
+	if str[0] == '-' {
+		return CodeInvalidChar, 0
+	}
+	for start := 0; start <= len(str); {
+		end := strings.IndexByte(str[start:], '/')
+		if end < 0 {
+			end = len(str)
+		} else {
+			end += start
+		}
+		if code, offset := goPathElement(str[start:end], module); code != "" {
+			return code, start + offset
+		}
+		start = end + 1
+	}
+	if !module {
+		return "", 0
+	}
+
+	first := str
+	if i := strings.IndexByte(str, '/'); i >= 0 {
+		first = str[:i]
+	}
+	for i := 0; i < len(first); i++ {
+		if c := first[i]; (c < 'a' || c > 'z') && (c < '0' || c > '9') && c != '.' && c != '-' {
+			return CodeInvalidChar, i
+		}
+	}
+	if strings.IndexByte(first, '.') < 0 {
+		return CodeBadFormat, 0
+	}
+	if offset := goMajorSuffix(str); offset >= 0 {
+		return CodeBadFormat, offset
+	}
+	return "", 0
+}
+
+// goPathElement returns the error code and offset of an invalid element of a
+// module or import path, or "".
+func goPathElement(elem string, module bool) (string, int) {
+	if elem == "" || strings.Trim(elem, ".") == "" {
+		return CodeBadFormat, 0
+	}
+	for i := 0; i < len(elem); i++ {
+		c := elem[i]
+		if alnumValue(c) < 0 && (c < 'a' || c > 'z') && !strings.ContainsRune("-._~", rune(c)) && (module || c != '+') {
+			return CodeInvalidChar, i
+		}
+	}
+	if elem[0] == '.' {
+		return CodeBadFormat, 0
+	}
+	if elem[len(elem)-1] == '.' {
+		return CodeBadFormat, len(elem) - 1
+	}
+
+	short := elem
+	if i := strings.IndexByte(short, '.'); i >= 0 {
+		short = short[:i]
+	}
+	for _, name := range goWindowsReserved {
+		if strings.EqualFold(short, name) {
+			return CodeBadFormat, 0
+		}
+	}
+	if i := strings.LastIndexByte(short, '~'); i >= 0 && i < len(short)-1 && Numeric(short[i+1:]) {
+		return CodeBadFormat, i
+	}
+	return "", 0
+}
+
+// goMajorSuffix returns the offset of an invalid major version suffix of a
+// module path, or -1.
+func goMajorSuffix(path string) int {
+	if strings.HasPrefix(path, "gopkg.in/") {
+		// gopkg.in paths end with .vN or .vN-unstable, v0 and v1 included
+		rest := strings.TrimSuffix(path, "-unstable")
+		i := strings.LastIndex(rest, ".v")
+		if i < 0 {
+			return len(path) - 1
+		}
+		if n := rest[i+2:]; n == "" || !Numeric(n) || n[0] == '0' && n != "0" {
+			return i
+		}
+		return -1
+	}
+
+	i, dot := len(path), false
+	for i > 0 && ('0' <= path[i-1] && path[i-1] <= '9' || path[i-1] == '.') {
+		dot = dot || path[i-1] == '.'
+		i--
+	}
+	if i <= 1 || i == len(path) || path[i-2:i] != "/v" {
+		return -1
+	}
+	if major := path[i-2:]; dot || major[2] == '0' || major == "/v1" {
+		return i - 1
+	}
+	return -1
+}
+
+// GoPseudoVersionParts holds a parsed Go pseudo-version.
+type GoPseudoVersionParts struct {
+	// Base is the version the pseudo-version sorts after, e.g. v1.2.3 for
+	// v1.2.4-0.20191109021931-daa7c04131f5, or "" for the form
+	// vX.0.0-yyyymmddhhmmss-abcdefabcdef.
+	Base string
+	// Time is the UTC commit time.
+	Time time.Time
+	// Revision is the 12 character prefix of the commit hash.
+	Revision string
+}
+
+// GoPseudoVersion check if the string is a Go pseudo-version, the version the
+// go command gives to an untagged commit, in one of its three forms:
+//
+//	vX.0.0-yyyymmddhhmmss-abcdefabcdef          no earlier tagged version
+//	vX.Y.Z-pre.0.yyyymmddhhmmss-abcdefabcdef    after the prerelease vX.Y.Z-pre
+//	vX.Y.(Z+1)-0.yyyymmddhhmmss-abcdefabcdef    after the release vX.Y.Z
+//
+// with a valid UTC commit time and the first 12 lower case hexadecimal digits
+// of the commit hash, and optionally the build metadata +incompatible.
+func GoPseudoVersion(str string) bool {
+	_, err := ParseGoPseudoVersion(str)
+	return err == nil
+}
+
+// ParseGoPseudoVersion parses a Go pseudo-version like GoPseudoVersion. On
+// failure the error is a *ValidationError.
+func ParseGoPseudoVersion(str string) (GoPseudoVersionParts, error) {
+	var p GoPseudoVersionParts
+	if str == "" {
+		return p, invalid("GoPseudoVersion", CodeEmpty, -1)
+	}
+	if str[0] != 'v' {
+		return p, invalid("GoPseudoVersion", CodeInvalidChar, 0)
+	}
+	v, err := ParseVersion(str)
+	if err != nil {
+		ve := err.(*ValidationError)
+		return p, invalid("GoPseudoVersion", ve.Code, ve.Offset)
+	}
+	build := ""
+	if len(v.Build) > 0 {
+		build = "+" + strings.Join(v.Build, ".")
+		if build != "+incompatible" {
+			return p, invalid("GoPseudoVersion", CodeBadFormat, len(str)-len(build))
+		}
+	}
+
+	// the commit time and revision make the last prerelease identifier
+	pre := v.Prerelease
+	n := len(pre)
+	if n == 0 {
+		return p, invalid("GoPseudoVersion", CodeBadFormat, -1)
+	}
+	stamp := pre[n-1]
+	offset := len(str) - len(build) - len(stamp)
+	if len(stamp) != 27 || stamp[14] != '-' {
+		return p, invalid("GoPseudoVersion", CodeBadFormat, offset)
+	}
+	t, err := time.Parse("20060102150405", stamp[:14])
+	if err != nil || !Numeric(stamp[:14]) {
+		return p, invalid("GoPseudoVersion", CodeBadFormat, offset)
+	}
+	for i := 15; i < len(stamp); i++ {
+		if c := stamp[i]; (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return p, invalid("GoPseudoVersion", CodeInvalidChar, offset+i)
+		}
+	}
+
+	base := v
+	base.Prerelease, base.Build = nil, nil
+	switch {
+	case n == 1 && v.Minor == 0 && v.Patch == 0:
+		// no base version
+	case n == 2 && pre[0] == "0" && v.Patch > 0:
+		base.Patch--
+		p.Base = "v" + base.String()
+	case n >= 3 && pre[n-2] == "0":
+		base.Prerelease = pre[:n-2]
+		p.Base = "v" + base.String()
+	default:
+		return p, invalid("GoPseudoVersion", CodeBadFormat, -1)
+	}
+	p.Time, p.Revision = t, stamp[15:]
+	return p, nil
+}
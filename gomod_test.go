@@ -0,0 +1,181 @@
+package is
+
+import (
+	"testing"
+	"time"
+)
+
+func TestGoPaths(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) bool
+		name     string
+		param    string
+		expected bool
+	}{
+		{GoModulePath, "GoModulePath", "github.com/alioygur/is", true},
+		{GoModulePath, "GoModulePath", "golang.org/x/mod", true},
+		{GoModulePath, "GoModulePath", "github.com/user/repo/v2", true},
+		{GoModulePath, "GoModulePath", "github.com/user/repo/v10", true},
+		{GoModulePath, "GoModulePath", "example.com/My_Repo-1.x~y", true},
+		{GoModulePath, "GoModulePath", "gopkg.in/yaml.v3", true},
+		{GoModulePath, "GoModulePath", "gopkg.in/check.v1", true},
+		{GoModulePath, "GoModulePath", "gopkg.in/src-d/go-git.v4", true},
+		{GoModulePath, "GoModulePath", "gopkg.in/foo.v0-unstable", true},
+		{GoModulePath, "GoModulePath", "example.com/v1x", true},
+		{GoModulePath, "GoModulePath", "example.com/console", true},
+		{GoModulePath, "GoModulePath", "github.com/user/repo/v1", false},
+		{GoModulePath, "GoModulePath", "github.com/user/repo/v0", false},
+		{GoModulePath, "GoModulePath", "github.com/user/repo/v02", false},
+		{GoModulePath, "GoModulePath", "github.com/user/repo/v2.1", false},
+		{GoModulePath, "GoModulePath", "gopkg.in/yaml", false},
+		{GoModulePath, "GoModulePath", "gopkg.in/yaml.v03", false},
+		{GoModulePath, "GoModulePath", "fmt", false},
+		{GoModulePath, "GoModulePath", "GitHub.com/user/repo", false},
+		{GoModulePath, "GoModulePath", "-example.com/repo", false},
+		{GoModulePath, "GoModulePath", "example.com/repo+x", false},
+		{GoModulePath, "GoModulePath", "example.com//repo", false},
+		{GoModulePath, "GoModulePath", "example.com/repo/", false},
+		{GoModulePath, "GoModulePath", "/example.com/repo", false},
+		{GoModulePath, "GoModulePath", "example.com/.repo", false},
+		{GoModulePath, "GoModulePath", "example.com/repo.", false},
+		{GoModulePath, "GoModulePath", "example.com/../repo", false},
+		{GoModulePath, "GoModulePath", "example.com/con", false},
+		{GoModulePath, "GoModulePath", "example.com/Aux.txt", false},
+		{GoModulePath, "GoModulePath", "example.com/lpt9", false},
+		{GoModulePath, "GoModulePath", "example.com/progra~1", false},
+		{GoModulePath, "GoModulePath", "example.com/répo", false},
+		{GoModulePath, "GoModulePath", "", false},
+		{GoImportPath, "GoImportPath", "fmt", true},
+		{GoImportPath, "GoImportPath", "net/http", true},
+		{GoImportPath, "GoImportPath", "github.com/user/repo/internal/pkg", true},
+		{GoImportPath, "GoImportPath", "github.com/user/repo/v1", true},
+		{GoImportPath, "GoImportPath", "example.com/c++", true},
+		{GoImportPath, "GoImportPath", "Example.com/Pkg", true},
+		{GoImportPath, "GoImportPath", "net//http", false},
+		{GoImportPath, "GoImportPath", "net/http/", false},
+		{GoImportPath, "GoImportPath", "net/http:2", false},
+		{GoImportPath, "GoImportPath", "example.com/nul", false},
+		{GoImportPath, "GoImportPath", "-fmt", false},
+		{GoImportPath, "GoImportPath", "", false},
+	}
+	for _, test := range tests {
+		if actual := test.function(test.param); actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestCheckGoPaths(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) error
+		name     string
+		param    string
+		code     string
+		offset   int
+	}{
+		{CheckGoModulePath, "GoModulePath", "", CodeEmpty, -1},
+		{CheckGoModulePath, "GoModulePath", "-example.com/repo", CodeInvalidChar, 0},
+		{CheckGoModulePath, "GoModulePath", "example.com/re po", CodeInvalidChar, 14},
+		{CheckGoModulePath, "GoModulePath", "example.com//repo", CodeBadFormat, 12},
+		{CheckGoModulePath, "GoModulePath", "example.com/repo/", CodeBadFormat, 17},
+		{CheckGoModulePath, "GoModulePath", "example.com/repo.", CodeBadFormat, 16},
+		{CheckGoModulePath, "GoModulePath", "example.com/a/COM1", CodeBadFormat, 14},
+		{CheckGoModulePath, "GoModulePath", "example.com/progra~1", CodeBadFormat, 18},
+		{CheckGoModulePath, "GoModulePath", "Example.com/repo", CodeInvalidChar, 0},
+		{CheckGoModulePath, "GoModulePath", "example/repo", CodeBadFormat, 0},
+		{CheckGoModulePath, "GoModulePath", "example.com/repo/v1", CodeBadFormat, 17},
+		{CheckGoModulePath, "GoModulePath", "gopkg.in/yaml.v03", CodeBadFormat, 13},
+		{CheckGoImportPath, "GoImportPath", "net/http:2", CodeInvalidChar, 8},
+	}
+	for _, test := range tests {
+		err := test.function(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != test.name || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected Check%s(%q) to fail with %s at %d, got %v", test.name, test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestGoPseudoVersion(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"v0.0.0-20191109021931-daa7c04131f5", true},
+		{"v2.0.0-20191109021931-daa7c04131f5+incompatible", true},
+		{"v1.2.4-0.20191109021931-daa7c04131f5", true},
+		{"v1.2.3-pre.0.20191109021931-daa7c04131f5", true},
+		{"v1.2.3-rc.1.0.20191109021931-daa7c04131f5", true},
+		{"v1.2.3", false},
+		{"v1.2.3-pre", false},
+		{"0.0.0-20191109021931-daa7c04131f5", false},
+		{"v1.2.0-20191109021931-daa7c04131f5", false},
+		{"v1.2.0-0.20191109021931-daa7c04131f5", false},
+		{"v1.2.3-pre.20191109021931-daa7c04131f5", false},
+		{"v0.0.0-20191309021931-daa7c04131f5", false},
+		{"v0.0.0-20191109251931-daa7c04131f5", false},
+		{"v0.0.0-2019110902193-daa7c04131f5", false},
+		{"v0.0.0-20191109021931-daa7c04131f", false},
+		{"v0.0.0-20191109021931-DAA7C04131F5", false},
+		{"v0.0.0-20191109021931-daa7c04131g5", false},
+		{"v0.0.0-20191109021931-daa7c04131f5+meta", false},
+		{"", false},
+	}
+	for _, test := range tests {
+		if actual := GoPseudoVersion(test.param); actual != test.expected {
+			t.Errorf("Expected GoPseudoVersion(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseGoPseudoVersion(t *testing.T) {
+	t.Parallel()
+
+	commit := time.Date(2019, 11, 9, 2, 19, 31, 0, time.UTC)
+	var tests = []struct {
+		param string
+		base  string
+	}{
+		{"v0.0.0-20191109021931-daa7c04131f5", ""},
+		{"v1.2.4-0.20191109021931-daa7c04131f5", "v1.2.3"},
+		{"v1.2.10-0.20191109021931-daa7c04131f5", "v1.2.9"},
+		{"v1.2.100-0.20191109021931-daa7c04131f5", "v1.2.99"},
+		{"v1.2.3-rc.1.0.20191109021931-daa7c04131f5", "v1.2.3-rc.1"},
+		{"v2.0.1-0.20191109021931-daa7c04131f5+incompatible", "v2.0.0"},
+	}
+	for _, test := range tests {
+		p, err := ParseGoPseudoVersion(test.param)
+		if err != nil || p.Base != test.base || !p.Time.Equal(commit) || p.Revision != "daa7c04131f5" {
+			t.Errorf("Expected ParseGoPseudoVersion(%q) to be %q, %v, daa7c04131f5, got %+v, %v", test.param, test.base, commit, p, err)
+		}
+	}
+
+	var errors = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"1.2.3", CodeInvalidChar, 0},
+		{"v1.2", CodeBadFormat, -1},
+		{"v1.2.3", CodeBadFormat, -1},
+		{"v0.0.0-20191109021931-daa7c04131f5+meta", CodeBadFormat, 34},
+		{"v0.0.0-2019110902193-daa7c04131f5", CodeBadFormat, 7},
+		{"v1.2.4-0.20191109021961-daa7c04131f5", CodeBadFormat, 9},
+		{"v0.0.0-20191109021931-daa7c04131x5", CodeInvalidChar, 32},
+		{"v1.2.0-20191109021931-daa7c04131f5", CodeBadFormat, -1},
+	}
+	for _, test := range errors {
+		_, err := ParseGoPseudoVersion(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "GoPseudoVersion" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseGoPseudoVersion(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
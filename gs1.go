@@ -0,0 +1,148 @@
+package is
+
+import (
+	"strings"
+
+	"github.com/alioygur/is/checkdigit"
+)
+
+// gtin reports whether str is a GS1 identification number of one of the
+// lengths, made of digits only and ending with a GS1 mod 10 check digit.
+func gtin(str string, lengths ...int) bool {
+	return containsInt(lengths, len(str)) && checkdigit.GS1{}.Validate(str)
+}
+
+// EAN8 check if the string is an EAN-8 (GTIN-8) barcode number of 8 digits.
+func EAN8(str string) bool {
+	return gtin(str, 8)
+}
+
+// EAN13 check if the string is an EAN-13 (GTIN-13) barcode number of 13 digits.
+func EAN13(str string) bool {
+	return gtin(str, 13)
+}
+
+// UPCA check if the string is a UPC-A (GTIN-12) barcode number of 12 digits.
+func UPCA(str string) bool {
+	return gtin(str, 12)
+}
+
+// GTIN check if the string is a Global Trade Item Number of 8, 12, 13 or 14
+// digits, i.e. an EAN-8, UPC-A, EAN-13 or GTIN-14.
+func GTIN(str string) bool {
+	return gtin(str, 8, 12, 13, 14)
+}
+
+// SSCC check if the string is a Serial Shipping Container Code of 18 digits.
+func SSCC(str string) bool {
+	return gtin(str, 18)
+}
+
+// UPCE check if the string is a zero-suppressed UPC-E barcode number of 8
+// digits: the number system 0 or 1, six digits and the check digit of the
+// UPC-A it expands to.
+func UPCE(str string) bool {
+	_, err := UPCEToUPCA(str)
+	return err == nil
+}
+
+// UPCEToUPCA validates a UPC-E barcode number like UPCE and returns the UPC-A
+// number it stands for, e.g. 042100005264 for 04252614. On failure the error
+// is a *ValidationError.
+func UPCEToUPCA(str string) (string, error) {
+	if str == "" {
+		return "", invalid("UPCE", CodeEmpty, -1)
+	}
+	if len(str) != 8 {
+		return "", invalid("UPCE", CodeBadLength, -1)
+	}
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' {
+			return "", invalid("UPCE", CodeInvalidChar, i)
+		}
+	}
+	if str[0] > '1' {
+		return "", invalid("UPCE", CodeBadFormat, 0)
+	}
+
+	// the last of the six digits tells where the zeros were suppressed
+	ns, d := str[:1], str[1:7]
+	var upca string
+	switch d[5] {
+	case '0', '1', '2':
+		upca = ns + d[:2] + d[5:] + "0000" + d[2:5]
+	case '3':
+		upca = ns + d[:3] + "00000" + d[3:5]
+	case '4':
+		upca = ns + d[:4] + "00000" + d[4:5]
+	default:
+		upca = ns + d[:5] + "0000" + d[5:]
+	}
+	upca += str[7:]
+	if !(checkdigit.GS1{}).Validate(upca) {
+		return "", invalid("UPCE", CodeBadChecksum, 7)
+	}
+	return upca, nil
+}
+
+// ISSN check if the string is an International Standard Serial Number of 8
+// characters, written as 0378-5955 or 03785955, whose last character is a
+// weighted mod 11 check digit or X.
+func ISSN(str string) bool {
+	if len(str) == 9 && str[4] == '-' {
+		str = str[:4] + str[5:]
+	}
+	return len(str) == 8 && checkdigit.WeightedMod11{}.Validate(str)
+}
+
+// ISMN check if the string is an International Standard Music Number: 13
+// digits starting with 9790, as in 979-0-2600-0043-8, or the former 10
+// character form starting with M, as in M-2306-7118-7, which stands for the
+// same number with M replaced by 979-0. Spaces and hyphens are ignored.
+func ISMN(str string) bool {
+	str = strings.NewReplacer("-", "", " ", "").Replace(str)
+	if len(str) == 10 && str[0] == 'M' {
+		str = "9790" + str[1:]
+	}
+	return len(str) == 13 && strings.HasPrefix(str, "9790") && checkdigit.GS1{}.Validate(str)
+}
+
+// ISBN10To13 validates an ISBN-10 like ISBN and returns the ISBN-13 of the
+// same book, without separators, e.g. 9780306406157 for 0-306-40615-2. On
+// failure the error is the *ValidationError of CheckISBN.
+func ISBN10To13(str string) (string, error) {
+	if err := CheckISBN(str, 10); err != nil {
+		return "", err
+	}
+	payload := "978" + isbnDigits(str)[:9]
+	check, _ := checkdigit.GS1{}.Compute(payload)
+	return payload + check, nil
+}
+
+// ISBN13To10 validates an ISBN-13 like ISBN and returns the ISBN-10 of the
+// same book, without separators. Only ISBN-13 starting with 978 have an
+// ISBN-10; others fail with CodeBadFormat. On failure the error is a
+// *ValidationError.
+func ISBN13To10(str string) (string, error) {
+	if err := CheckISBN(str, 13); err != nil {
+		return "", err
+	}
+	digits := isbnDigits(str)
+	if !strings.HasPrefix(digits, "978") {
+		return "", invalid("ISBN", CodeBadFormat, -1)
+	}
+	payload := digits[3:12]
+	check, _ := checkdigit.WeightedMod11{}.Compute(payload)
+	return payload + check, nil
+}
+
+// isbnDigits returns str without the separators allowed by ISBN.
+func isbnDigits(str string) string {
+	var b strings.Builder
+	for i := 0; i < len(str); i++ {
+		if !isbnSeparator(str[i]) {
+			b.WriteByte(str[i])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,142 @@
+package is
+
+import "testing"
+
+func TestGTIN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) bool
+		name     string
+		param    string
+		expected bool
+	}{
+		{EAN8, "EAN8", "96385074", true},
+		{EAN8, "EAN8", "96385075", false},
+		{EAN8, "EAN8", "9638507", false},
+		{EAN8, "EAN8", "4006381333931", false},
+		{EAN13, "EAN13", "4006381333931", true},
+		{EAN13, "EAN13", "9780306406157", true},
+		{EAN13, "EAN13", "4006381333932", false},
+		{EAN13, "EAN13", "400638133393a", false},
+		{EAN13, "EAN13", "", false},
+		{UPCA, "UPCA", "036000291452", true},
+		{UPCA, "UPCA", "036000291453", false},
+		{UPCA, "UPCA", "36000291452", false},
+		{GTIN, "GTIN", "96385074", true},
+		{GTIN, "GTIN", "036000291452", true},
+		{GTIN, "GTIN", "4006381333931", true},
+		{GTIN, "GTIN", "10614141000415", true},
+		{GTIN, "GTIN", "10614141000416", false},
+		{GTIN, "GTIN", "006141410004159", false},
+		{SSCC, "SSCC", "106141410000000019", true},
+		{SSCC, "SSCC", "006141410000000012", true},
+		{SSCC, "SSCC", "106141410000000010", false},
+		{SSCC, "SSCC", "10614141000000001", false},
+		{UPCE, "UPCE", "04252614", true},
+		{UPCE, "UPCE", "01234565", true},
+		{UPCE, "UPCE", "12345670", true},
+		{UPCE, "UPCE", "04252615", false},
+		{UPCE, "UPCE", "24252614", false},
+		{UPCE, "UPCE", "0425261", false},
+		{ISSN, "ISSN", "0378-5955", true},
+		{ISSN, "ISSN", "03785955", true},
+		{ISSN, "ISSN", "1050-124X", true},
+		{ISSN, "ISSN", "1050-124x", false},
+		{ISSN, "ISSN", "0378-5956", false},
+		{ISSN, "ISSN", "03-785955", false},
+		{ISSN, "ISSN", "0378595", false},
+		{ISMN, "ISMN", "979-0-2600-0043-8", true},
+		{ISMN, "ISMN", "9790260000438", true},
+		{ISMN, "ISMN", "M-2306-7118-7", true},
+		{ISMN, "ISMN", "M230671187", true},
+		{ISMN, "ISMN", "979-0-2600-0043-9", false},
+		{ISMN, "ISMN", "9780306406157", false},
+		{ISMN, "ISMN", "M-2306-7118-8", false},
+	}
+	for _, test := range tests {
+		if actual := test.function(test.param); actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestUPCEToUPCA(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected string
+	}{
+		{"04252614", "042100005264"},
+		{"01234565", "012345000065"},
+		{"01234531", "012300000451"},
+		{"01234543", "012340000053"},
+		{"01234505", "012000003455"},
+		{"12345670", "123456000070"},
+	}
+	for _, test := range tests {
+		actual, err := UPCEToUPCA(test.param)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected UPCEToUPCA(%q) to be %q, got %q, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestUPCEToUPCAErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"0425261", CodeBadLength, -1},
+		{"0425261a", CodeInvalidChar, 7},
+		{"24252614", CodeBadFormat, 0},
+		{"04252615", CodeBadChecksum, 7},
+	}
+	for _, test := range tests {
+		_, err := UPCEToUPCA(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "UPCE" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected UPCEToUPCA(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestISBNConversion(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		isbn10 string
+		isbn13 string
+	}{
+		{"0306406152", "9780306406157"},
+		{"080442957X", "9780804429573"},
+		{"3836221195", "9783836221191"},
+	}
+	for _, test := range tests {
+		if actual, err := ISBN10To13(test.isbn10); err != nil || actual != test.isbn13 {
+			t.Errorf("Expected ISBN10To13(%q) to be %q, got %q, %v", test.isbn10, test.isbn13, actual, err)
+		}
+		if actual, err := ISBN13To10(test.isbn13); err != nil || actual != test.isbn10 {
+			t.Errorf("Expected ISBN13To10(%q) to be %q, got %q, %v", test.isbn13, test.isbn10, actual, err)
+		}
+	}
+
+	if actual, err := ISBN10To13("0-306-40615-2"); err != nil || actual != "9780306406157" {
+		t.Errorf("Expected ISBN10To13(%q) to be %q, got %q, %v", "0-306-40615-2", "9780306406157", actual, err)
+	}
+	if actual, err := ISBN13To10("978-0-306-40615-7"); err != nil || actual != "0306406152" {
+		t.Errorf("Expected ISBN13To10(%q) to be %q, got %q, %v", "978-0-306-40615-7", "0306406152", actual, err)
+	}
+	if _, err := ISBN10To13("0306406153"); err == nil {
+		t.Errorf("Expected ISBN10To13(%q) to fail", "0306406153")
+	}
+	_, err := ISBN13To10("9791234567896")
+	if ve, ok := err.(*ValidationError); !ok || ve.Code != CodeBadFormat {
+		t.Errorf("Expected ISBN13To10(%q) to fail with %s, got %v", "9791234567896", CodeBadFormat, err)
+	}
+}
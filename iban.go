@@ -0,0 +1,268 @@
+package is
+
+import "github.com/alioygur/is/checkdigit"
+
+// IBANEntry stores the IBAN structure of a country
+type IBANEntry struct {
+	Country string
+	Length  int
+	// BBAN is the structure of the basic bank account number in SWIFT
+	// notation: "4!n" is 4 digits, "4!a" 4 upper case letters and "4!c"
+	// 4 letters or digits.
+	BBAN string
+	// BankStart and BankEnd delimit the bank identifier in the BBAN.
+	BankStart, BankEnd int
+	// BranchStart and BranchEnd delimit the branch identifier in the BBAN;
+	// they are equal if the country has none.
+	BranchStart, BranchEnd int
+}
+
+// IBANList based on the SWIFT IBAN Registry https://www.swift.com/standards/data-standards/iban
+var IBANList = []IBANEntry{
+	{"AD", 24, "4!n4!n12!c", 0, 4, 4, 8},
+	{"AE", 23, "3!n16!n", 0, 3, 0, 0},
+	{"AL", 28, "8!n16!c", 0, 3, 3, 7},
+	{"AT", 20, "5!n11!n", 0, 5, 0, 0},
+	{"AZ", 28, "4!a20!c", 0, 4, 0, 0},
+	{"BA", 20, "3!n3!n8!n2!n", 0, 3, 3, 6},
+	{"BE", 16, "3!n7!n2!n", 0, 3, 0, 0},
+	{"BG", 22, "4!a4!n2!n8!c", 0, 4, 4, 8},
+	{"BH", 22, "4!a14!c", 0, 4, 0, 0},
+	{"BI", 27, "5!n5!n11!n2!n", 0, 5, 5, 10},
+	{"BR", 29, "8!n5!n10!n1!a1!c", 0, 8, 8, 13},
+	{"BY", 28, "4!c4!n16!c", 0, 4, 0, 0},
+	{"CH", 21, "5!n12!c", 0, 5, 0, 0},
+	{"CR", 22, "4!n14!n", 0, 4, 0, 0},
+	{"CY", 28, "3!n5!n16!c", 0, 3, 3, 8},
+	{"CZ", 24, "4!n6!n10!n", 0, 4, 0, 0},
+	{"DE", 22, "8!n10!n", 0, 8, 0, 0},
+	{"DJ", 27, "5!n5!n11!n2!n", 0, 5, 5, 10},
+	{"DK", 18, "4!n9!n1!n", 0, 4, 0, 0},
+	{"DO", 28, "4!c20!n", 0, 4, 0, 0},
+	{"EE", 20, "2!n2!n11!n1!n", 0, 2, 0, 0},
+	{"EG", 29, "4!n4!n17!n", 0, 4, 4, 8},
+	{"ES", 24, "4!n4!n1!n1!n10!n", 0, 4, 4, 8},
+	{"FI", 18, "3!n11!n", 0, 3, 0, 0},
+	{"FK", 18, "2!a12!n", 0, 2, 0, 0},
+	{"FO", 18, "4!n9!n1!n", 0, 4, 0, 0},
+	{"FR", 27, "5!n5!n11!c2!n", 0, 5, 5, 10},
+	{"GB", 22, "4!a6!n8!n", 0, 4, 4, 10},
+	{"GE", 22, "2!a16!n", 0, 2, 0, 0},
+	{"GI", 23, "4!a15!c", 0, 4, 0, 0},
+	{"GL", 18, "4!n9!n1!n", 0, 4, 0, 0},
+	{"GR", 27, "3!n4!n16!c", 0, 3, 3, 7},
+	{"GT", 28, "4!c20!c", 0, 4, 0, 0},
+	{"HR", 21, "7!n10!n", 0, 7, 0, 0},
+	{"HU", 28, "3!n4!n1!n15!n1!n", 0, 3, 3, 7},
+	{"IE", 22, "4!a6!n8!n", 0, 4, 4, 10},
+	{"IL", 23, "3!n3!n13!n", 0, 3, 3, 6},
+	{"IQ", 23, "4!a3!n12!n", 0, 4, 4, 7},
+	{"IS", 26, "4!n2!n6!n10!n", 0, 2, 2, 4},
+	{"IT", 27, "1!a5!n5!n12!c", 1, 6, 6, 11},
+	{"JO", 30, "4!a4!n18!c", 0, 4, 4, 8},
+	{"KW", 30, "4!a22!c", 0, 4, 0, 0},
+	{"KZ", 20, "3!n13!c", 0, 3, 0, 0},
+	{"LB", 28, "4!n20!c", 0, 4, 0, 0},
+	{"LC", 32, "4!a24!c", 0, 4, 0, 0},
+	{"LI", 21, "5!n12!c", 0, 5, 0, 0},
+	{"LT", 20, "5!n11!n", 0, 5, 0, 0},
+	{"LU", 20, "3!n13!c", 0, 3, 0, 0},
+	{"LV", 21, "4!a13!c", 0, 4, 0, 0},
+	{"LY", 25, "3!n3!n15!n", 0, 3, 3, 6},
+	{"MC", 27, "5!n5!n11!c2!n", 0, 5, 5, 10},
+	{"MD", 24, "2!c18!c", 0, 2, 0, 0},
+	{"ME", 22, "3!n13!n2!n", 0, 3, 0, 0},
+	{"MK", 19, "3!n10!c2!n", 0, 3, 0, 0},
+	{"MN", 20, "4!n12!n", 0, 4, 0, 0},
+	{"MR", 27, "5!n5!n11!n2!n", 0, 5, 5, 10},
+	{"MT", 31, "4!a5!n18!c", 0, 4, 4, 9},
+	{"MU", 30, "4!a2!n2!n12!n3!n3!a", 0, 6, 6, 8},
+	{"NI", 28, "4!a20!n", 0, 4, 0, 0},
+	{"NL", 18, "4!a10!n", 0, 4, 0, 0},
+	{"NO", 15, "4!n6!n1!n", 0, 4, 0, 0},
+	{"OM", 23, "3!n16!c", 0, 3, 0, 0},
+	{"PK", 24, "4!a16!c", 0, 4, 0, 0},
+	{"PL", 28, "8!n16!n", 0, 8, 0, 0},
+	{"PS", 29, "4!a21!c", 0, 4, 0, 0},
+	{"PT", 25, "4!n4!n11!n2!n", 0, 4, 4, 8},
+	{"QA", 29, "4!a21!c", 0, 4, 0, 0},
+	{"RO", 24, "4!a16!c", 0, 4, 0, 0},
+	{"RS", 22, "3!n13!n2!n", 0, 3, 0, 0},
+	{"RU", 33, "9!n5!n15!c", 0, 9, 9, 14},
+	{"SA", 24, "2!n18!c", 0, 2, 0, 0},
+	{"SC", 31, "4!a2!n2!n16!n3!a", 0, 6, 6, 8},
+	{"SD", 18, "2!n12!n", 0, 2, 0, 0},
+	{"SE", 24, "3!n16!n1!n", 0, 3, 0, 0},
+	{"SI", 19, "5!n8!n2!n", 0, 5, 0, 0},
+	{"SK", 24, "4!n6!n10!n", 0, 4, 0, 0},
+	{"SM", 27, "1!a5!n5!n12!c", 1, 6, 6, 11},
+	{"SO", 23, "4!n3!n12!n", 0, 4, 4, 7},
+	{"ST", 25, "4!n4!n11!n2!n", 0, 4, 4, 8},
+	{"SV", 28, "4!a20!n", 0, 4, 0, 0},
+	{"TL", 23, "3!n14!n2!n", 0, 3, 0, 0},
+	{"TN", 24, "2!n3!n13!n2!n", 0, 2, 2, 5},
+	{"TR", 26, "5!n1!n16!c", 0, 5, 0, 0},
+	{"UA", 29, "6!n19!c", 0, 6, 0, 0},
+	{"VA", 22, "3!n15!n", 0, 3, 0, 0},
+	{"VG", 24, "4!a16!n", 0, 4, 0, 0},
+	// Kosovo uses the user-assigned code XK, which is not part of ISO3166List.
+	{"XK", 20, "4!n10!n2!n", 0, 2, 2, 4},
+}
+
+// ibanMaxLength is the longest IBAN allowed by ISO 13616.
+const ibanMaxLength = 34
+
+// IBANParts is an IBAN split into its components.
+type IBANParts struct {
+	// CountryCode is the ISO 3166 alpha-2 code of the account's country.
+	CountryCode string
+	// CheckDigits are the two ISO 7064 MOD 97-10 check digits.
+	CheckDigits string
+	// BBAN is the basic bank account number, everything after the check digits.
+	BBAN string
+	// BankCode identifies the bank.
+	BankCode string
+	// BranchCode identifies the branch, if the country has one.
+	BranchCode string
+	// Account is the rest of the BBAN.
+	Account string
+}
+
+// IBAN check if the string is an International Bank Account Number. Spaces
+// are ignored and lower case letters are accepted, so both the electronic
+// (DE89370400440532013000) and paper (DE89 3704 0044 0532 0130 00) formats
+// are valid.
+func IBAN(str string) bool {
+	_, err := ParseIBAN(str)
+	return err == nil
+}
+
+// ParseIBAN validates an IBAN like IBAN and returns its components. The
+// country must be listed in both ISO3166List and IBANList, the length and
+// BBAN structure must match the country's entry in IBANList and the check
+// digits must verify. On failure the error is a *ValidationError whose
+// offsets refer to str.
+func ParseIBAN(str string) (IBANParts, error) {
+	var parts IBANParts
+	// compact upper case copy of str and the offset in str of each character
+	var buf [ibanMaxLength]byte
+	var pos [ibanMaxLength]int
+	n := 0
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c == ' ' {
+			continue
+		}
+		if n == ibanMaxLength {
+			return parts, invalid("IBAN", CodeTooLong, i)
+		}
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if ('Z' < c || c < 'A') && ('9' < c || c < '0') {
+			return parts, invalid("IBAN", CodeInvalidChar, i)
+		}
+		buf[n], pos[n] = c, i
+		n++
+	}
+	if n == 0 {
+		return parts, invalid("IBAN", CodeEmpty, -1)
+	}
+	if n < 4 {
+		return parts, invalid("IBAN", CodeTooShort, -1)
+	}
+	iban := buf[:n]
+
+	country := string(iban[:2])
+	entry := ibanEntry(country)
+	if entry == nil || !bankCountry(country) {
+		return parts, invalid("IBAN", CodeUnknownCountry, pos[0])
+	}
+	for i := 2; i < 4; i++ {
+		if iban[i] < '0' || iban[i] > '9' {
+			return parts, invalid("IBAN", CodeInvalidChar, pos[i])
+		}
+	}
+	switch {
+	case n < entry.Length:
+		return parts, invalid("IBAN", CodeTooShort, -1)
+	case n > entry.Length:
+		return parts, invalid("IBAN", CodeTooLong, pos[entry.Length])
+	}
+	if i := matchBBAN(entry.BBAN, iban[4:]); i >= 0 {
+		return parts, invalid("IBAN", CodeInvalidChar, pos[4+i])
+	}
+	if cd := string(iban[2:4]); cd == "00" || cd == "01" || cd == "99" || !ibanChecksum(iban) {
+		return parts, invalid("IBAN", CodeBadChecksum, pos[2])
+	}
+
+	bban := string(iban[4:])
+	parts = IBANParts{
+		CountryCode: country,
+		CheckDigits: string(iban[2:4]),
+		BBAN:        bban,
+		BankCode:    bban[entry.BankStart:entry.BankEnd],
+		BranchCode:  bban[entry.BranchStart:entry.BranchEnd],
+	}
+	end := entry.BankEnd
+	if entry.BranchEnd > end {
+		end = entry.BranchEnd
+	}
+	parts.Account = bban[end:]
+	return parts, nil
+}
+
+// bankCountry check if the string is a country code used in bank identifiers:
+// an ISO3166Alpha2 code or XK, which SWIFT assigns to Kosovo.
+func bankCountry(str string) bool {
+	return str == "XK" || ISO3166Alpha2(str)
+}
+
+// ibanEntry returns the IBANList entry of the country, or nil.
+func ibanEntry(country string) *IBANEntry {
+	for i := range IBANList {
+		if IBANList[i].Country == country {
+			return &IBANList[i]
+		}
+	}
+	return nil
+}
+
+// matchBBAN checks bban against a structure in SWIFT notation such as
+// "4!a6!n8!n". It returns the index of the first mismatching character, or
+// -1 if bban matches. The length of bban is assumed to be right.
+func matchBBAN(format string, bban []byte) int {
+	i := 0
+	for f := 0; f < len(format); {
+		count := 0
+		for ; format[f] >= '0' && format[f] <= '9'; f++ {
+			count = count*10 + int(format[f]-'0')
+		}
+		// skip "!" and read the character class
+		class := format[f+1]
+		f += 2
+		for end := i + count; i < end; i++ {
+			c := bban[i]
+			switch class {
+			case 'n':
+				if c < '0' || c > '9' {
+					return i
+				}
+			case 'a':
+				if c < 'A' || c > 'Z' {
+					return i
+				}
+			}
+		}
+	}
+	return -1
+}
+
+// ibanChecksum reports whether the IBAN passes ISO 7064 MOD 97-10 after
+// moving the country code and check digits to the end.
+func ibanChecksum(iban []byte) bool {
+	var buf [ibanMaxLength]byte
+	n := copy(buf[:], iban[4:])
+	n += copy(buf[n:], iban[:4])
+	return checkdigit.Mod97Radix10{}.Validate(string(buf[:n]))
+}
@@ -0,0 +1,200 @@
+package is
+
+import "testing"
+
+func TestIBANList(t *testing.T) {
+	t.Parallel()
+
+	for _, e := range IBANList {
+		// add up the counts of the BBAN fields, e.g. "4!a6!n8!n" is 18
+		n, count := 4, 0
+		for _, c := range e.BBAN {
+			if '0' <= c && c <= '9' {
+				count = count*10 + int(c-'0')
+			} else if c == '!' {
+				n += count
+				count = 0
+			}
+		}
+		if n != e.Length {
+			t.Errorf("IBANList entry %s has length %d but its BBAN %q adds up to %d", e.Country, e.Length, e.BBAN, n)
+		}
+		if e.BankStart > e.BankEnd || e.BranchStart > e.BranchEnd || e.BankEnd > n-4 || e.BranchEnd > n-4 {
+			t.Errorf("IBANList entry %s has bad bank or branch bounds", e.Country)
+		}
+	}
+}
+
+func TestIBAN(t *testing.T) {
+	t.Parallel()
+
+	valid := []string{
+		"DE89370400440532013000",
+		"GB29NWBK60161331926819",
+		"FR1420041010050500013M02606",
+		"NL91ABNA0417164300",
+		"BE68539007547034",
+		"CH9300762011623852957",
+		"ES9121000418450200051332",
+		"IT60X0542811101000000123456",
+		"AT611904300234573201",
+		"NO9386011117947",
+		"PL61109010140000071219812874",
+		"SE4550000000058398257466",
+		"TR330006100519786457841326",
+		"BR1800360305000010009795493C1",
+		"MU17BOMM0101101030300200000MUR",
+		"SC18SSCB11010000000000001497USD",
+		"XK051212012345678906",
+		"RU0304452522540817810538091310419",
+		"LC55HEMM000100010012001200023015",
+		"SA0380000000608010167519",
+		"AE070331234567890123456",
+		"IS140159260076545510730339",
+		"SM86U0322509800000000270100",
+		"PT50000201231234567890154",
+		"HU42117730161111101800000000",
+		"IL620108000000099999999",
+		"MT84MALT011000012345MTLCAST001S",
+		"GR1601101250000000012300695",
+		"IE29AIBK93115212345678",
+		"EE382200221020145685",
+		"LT121000011101001000",
+		"LV80BANK0000435195001",
+		"CZ6508000000192000145399",
+		"SK3112000000198742637541",
+		"DK5000400440116243",
+		"FI2112345600000785",
+		"RO49AAAA1B31007593840000",
+		"BG80BNBG96611020345678",
+		"HR1210010051863000160",
+		"SI56263300012039086",
+		"CY17002001280000001200527600",
+		"LU280019400644750000",
+		"MC5811222000010123456789030",
+		"AD1200012030200359100100",
+		"LI21088100002324013AA",
+		"GI75NWBK000000007099453",
+		"QA58DOHB00001234567890ABCDEFG",
+		"KW81CBKU0000000000001234560101",
+		"JO94CBJO0010000000000131000302",
+		"BH67BMAG00001299123456",
+		"PK36SCBL0000001123456702",
+		"PS92PALS000000000400123456702",
+		"AZ21NABZ00000000137010001944",
+		"GE29NB0000000101904917",
+		"KZ86125KZT5004100100",
+		"UA213223130000026007233566001",
+		"EG380019000500000000263180002",
+		"LY83002048000020100120361",
+		"SD2129010501234001",
+		"IQ98NBIQ850123456789012",
+		"BY13NBRB3600900000002Z00AB00",
+		"CR05015202001026284066",
+		"DO28BAGR00000001212453611324",
+		"GT82TRAJ01020000001210029690",
+		"SV62CENR00000000000000700025",
+		"VA59001123000012345678",
+		"VG96VPVG0000012345678901",
+		"TL380080012345678910157",
+		"ME25505000012345678951",
+		"RS35260005601001611379",
+		"MK07250120000058984",
+		"BA391290079401028494",
+		"AL47212110090000000235698741",
+		"MD24AG000225100013104168",
+		"MN121234123456789123",
+		"NI45BAPR00000013000003558124",
+		"SO211000001001000100141",
+		"BI4210000100010000332045181",
+		"DJ2100010000000154000100186",
+		"FK88SC123456789012",
+		"OM810180000001299123456",
+		"LB62099900000001001901229114",
+		"MR1300020001010000123456753",
+		"TN5910006035183598478831",
+		"FO6264600001631634",
+		"GL8964710001000206",
+	}
+	for _, s := range valid {
+		if !IBAN(s) {
+			t.Errorf("Expected IBAN(%q) to be true", s)
+		}
+	}
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"DE89 3704 0044 0532 0130 00", true},
+		{"de89370400440532013000", true},
+		{"DE89-3704-0044-0532-0130-00", false},
+		{"DE88370400440532013000", false},
+		{"DE8937040044053201300", false},
+		{"DE893704004405320130000", false},
+		{"DE8937040044053201300A", false},
+		{"GB29NWBK6016133192681", false},
+		{"GB2912345660161331926819", false},
+		{"XX89370400440532013000", false},
+		{"US64SVBKUS6S3300958879", false},
+		{"DEXX370400440532013000", false},
+		{"DE00370400440532013000", false},
+	}
+	for _, test := range tests {
+		if actual := IBAN(test.param); actual != test.expected {
+			t.Errorf("Expected IBAN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseIBAN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected IBANParts
+	}{
+		{"DE89 3704 0044 0532 0130 00", IBANParts{"DE", "89", "370400440532013000", "37040044", "", "0532013000"}},
+		{"GB29NWBK60161331926819", IBANParts{"GB", "29", "NWBK60161331926819", "NWBK", "601613", "31926819"}},
+		{"fr1420041010050500013m02606", IBANParts{"FR", "14", "20041010050500013M02606", "20041", "01005", "0500013M02606"}},
+		{"IT60X0542811101000000123456", IBANParts{"IT", "60", "X0542811101000000123456", "05428", "11101", "000000123456"}},
+		{"NL91ABNA0417164300", IBANParts{"NL", "91", "ABNA0417164300", "ABNA", "", "0417164300"}},
+	}
+	for _, test := range tests {
+		actual, err := ParseIBAN(test.param)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParseIBAN(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestParseIBANErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"   ", CodeEmpty, -1},
+		{"DE8", CodeTooShort, -1},
+		{"DE89 3704 0044 0532 0130 0", CodeTooShort, -1},
+		{"DE89 3704 0044 0532 0130 001", CodeTooLong, 27},
+		{"DE89 3704 0044 0532 0130 0.", CodeInvalidChar, 26},
+		{"XX89370400440532013000", CodeUnknownCountry, 0},
+		{"DEX9370400440532013000", CodeInvalidChar, 2},
+		{"DE89 3704 0044 0532 01A0 00", CodeInvalidChar, 22},
+		{"NL91 1BNA 0417 1643 00", CodeInvalidChar, 5},
+		{"DE88370400440532013000", CodeBadChecksum, 2},
+		{"GB29NWBK60161331926819GB29NWBK60161331926819", CodeTooLong, 34},
+	}
+	for _, test := range tests {
+		_, err := ParseIBAN(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "IBAN" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseIBAN(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
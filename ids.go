@@ -0,0 +1,410 @@
+package is
+
+import (
+	"strconv"
+	"strings"
+	"time"
+)
+
+// ULIDParts holds a parsed ULID.
+type ULIDParts struct {
+	// Time is the time of creation, with a resolution of a millisecond.
+	Time time.Time
+	// Bytes are the 16 bytes of the ULID: 6 bytes of time and 10 of
+	// randomness.
+	Bytes [16]byte
+}
+
+// ULID check if the string is a Universally Unique Lexicographically Sortable
+// Identifier such as 01ARZ3NDEKTSV4RRFFQ69G5FAV: 26 characters of Crockford's
+// base32, case-insensitively, encoding a 48-bit time in milliseconds and 80
+// bits of randomness. The first character is at most 7, as larger ones
+// overflow 128 bits.
+func ULID(str string) bool {
+	_, code, _ := parseULID(str)
+	return code == ""
+}
+
+// ParseULID parses a ULID like ULID. On failure the error is a
+// *ValidationError.
+func ParseULID(str string) (ULIDParts, error) {
+	u, code, offset := parseULID(str)
+	if code != "" {
+		return ULIDParts{}, invalid("ULID", code, offset)
+	}
+	return ULIDParts{Time: unixMilli(int64(bigEndian(u[:6]))), Bytes: u}, nil
+}
+
+func parseULID(str string) ([16]byte, string, int) {
+	var u [16]byte
+	if code, offset := fixedLength(str, 26); code != "" {
+		return u, code, offset
+	}
+	// 26 characters carry 130 bits, the 2 high ones must be zero
+	var digits [26]byte
+	for i := 0; i < len(str); i++ {
+		v := strings.IndexByte(crockfordBase32, upperASCII(str[i]))
+		if v < 0 {
+			return u, CodeInvalidChar, i
+		}
+		digits[i] = byte(v)
+	}
+	if digits[0] > 7 {
+		return u, CodeBadFormat, 0
+	}
+	decodeBase32(u[:], digits[:], 2)
+	return u, "", 0
+}
+
+// crockfordBase32 is the alphabet of Crockford's base32, without I, L, O
+// and U.
+const crockfordBase32 = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+
+// KSUIDParts holds a parsed KSUID.
+type KSUIDParts struct {
+	// Time is the time of creation, with a resolution of a second.
+	Time time.Time
+	// Payload is the 16 bytes of randomness.
+	Payload [16]byte
+}
+
+// ksuidEpoch is the Unix time in seconds of the KSUID epoch, 2014-05-13.
+const ksuidEpoch = 1400000000
+
+// KSUID check if the string is a K-Sortable Unique Identifier such as
+// 0ujtsYcgvSTl8PAuAdqWYSMnLOv: 27 base62 characters encoding a 32-bit time
+// in seconds since 2014-05-13 and 128 bits of randomness. Values beyond
+// aWgEPTl1tmebfsQzFP4bxwgy80V overflow 160 bits.
+func KSUID(str string) bool {
+	_, code, _ := parseKSUID(str)
+	return code == ""
+}
+
+// ParseKSUID parses a KSUID like KSUID. On failure the error is a
+// *ValidationError.
+func ParseKSUID(str string) (KSUIDParts, error) {
+	b, code, offset := parseKSUID(str)
+	if code != "" {
+		return KSUIDParts{}, invalid("KSUID", code, offset)
+	}
+	p := KSUIDParts{Time: time.Unix(ksuidEpoch+int64(bigEndian(b[:4])), 0).UTC()}
+	copy(p.Payload[:], b[4:])
+	return p, nil
+}
+
+func parseKSUID(str string) ([20]byte, string, int) {
+	var b [20]byte
+	if code, offset := fixedLength(str, 27); code != "" {
+		return b, code, offset
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		var v int
+		switch {
+		case '0' <= c && c <= '9':
+			v = int(c - '0')
+		case 'A' <= c && c <= 'Z':
+			v = int(c-'A') + 10
+		case 'a' <= c && c <= 'z':
+			v = int(c-'a') + 36
+		default:
+			return b, CodeInvalidChar, i
+		}
+		// b = b*62 + v, in big-endian order
+		carry := v
+		for j := len(b) - 1; j >= 0; j-- {
+			x := int(b[j])*62 + carry
+			b[j], carry = byte(x), x>>8
+		}
+		if carry != 0 {
+			return b, CodeBadFormat, 0
+		}
+	}
+	return b, "", 0
+}
+
+// XIDParts holds a parsed XID.
+type XIDParts struct {
+	// Time is the time of creation, with a resolution of a second.
+	Time time.Time
+	// Machine is the 3 byte machine identifier.
+	Machine [3]byte
+	// PID is the process identifier.
+	PID uint16
+	// Counter is the 24-bit counter, starting at a random value.
+	Counter uint32
+}
+
+// XID check if the string is an XID, the globally unique identifier of the
+// rs/xid library, such as 9m4e2mr0ui3e8a215n4g: 20 lower case base32hex
+// characters (0-9 and a-v) encoding 12 bytes, a 32-bit time in seconds, a
+// 3-byte machine identifier, a 2-byte process identifier and a 3-byte
+// counter. The unused low bits of the last character must be zero.
+func XID(str string) bool {
+	_, code, _ := parseXID(str)
+	return code == ""
+}
+
+// ParseXID parses an XID like XID. On failure the error is a
+// *ValidationError.
+func ParseXID(str string) (XIDParts, error) {
+	b, code, offset := parseXID(str)
+	if code != "" {
+		return XIDParts{}, invalid("XID", code, offset)
+	}
+	p := XIDParts{
+		Time:    time.Unix(int64(bigEndian(b[:4])), 0).UTC(),
+		PID:     uint16(bigEndian(b[7:9])),
+		Counter: uint32(bigEndian(b[9:])),
+	}
+	copy(p.Machine[:], b[4:7])
+	return p, nil
+}
+
+func parseXID(str string) ([12]byte, string, int) {
+	var b [12]byte
+	if code, offset := fixedLength(str, 20); code != "" {
+		return b, code, offset
+	}
+	var digits [20]byte
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		switch {
+		case '0' <= c && c <= '9':
+			digits[i] = c - '0'
+		case 'a' <= c && c <= 'v':
+			digits[i] = c - 'a' + 10
+		default:
+			return b, CodeInvalidChar, i
+		}
+	}
+	// 20 characters carry 100 bits, the 4 low ones must be zero
+	if digits[19]&0x0f != 0 {
+		return b, CodeBadFormat, 19
+	}
+	decodeBase32(b[:], digits[:], 0)
+	return b, "", 0
+}
+
+// SnowflakeParts holds a parsed Snowflake ID.
+type SnowflakeParts struct {
+	// Time is the time of creation, with a resolution of a millisecond.
+	Time time.Time
+	// Node is the 10-bit identifier of the generator, split by Twitter into
+	// a datacenter and a worker and by Discord into a worker and a process
+	// of 5 bits each.
+	Node int
+	// Sequence is the 12-bit sequence number within the millisecond.
+	Sequence int
+}
+
+// Epochs of well-known Snowflake generators, for ParseSnowflake.
+var (
+	// TwitterEpoch is the epoch of Twitter Snowflakes, 2010-11-04T01:42:54.657Z.
+	TwitterEpoch = time.Unix(1288834974, 657000000).UTC()
+	// DiscordEpoch is the epoch of Discord Snowflakes, 2015-01-01T00:00:00Z.
+	DiscordEpoch = time.Unix(1420070400, 0).UTC()
+)
+
+// Snowflake check if the string is a Snowflake ID such as
+// 1541815603606036480: the decimal form, without leading zeros, of a positive
+// 63-bit integer made of a 41-bit time in milliseconds since an epoch, a
+// 10-bit node and a 12-bit sequence.
+func Snowflake(str string) bool {
+	_, code, _ := parseSnowflake(str)
+	return code == ""
+}
+
+// ParseSnowflake parses a Snowflake ID like Snowflake, reading its time from
+// epoch, e.g. TwitterEpoch or DiscordEpoch. On failure the error is a
+// *ValidationError.
+func ParseSnowflake(str string, epoch time.Time) (SnowflakeParts, error) {
+	id, code, offset := parseSnowflake(str)
+	if code != "" {
+		return SnowflakeParts{}, invalid("Snowflake", code, offset)
+	}
+	return SnowflakeParts{
+		Time:     epoch.Add(time.Duration(id>>22) * time.Millisecond).UTC(),
+		Node:     int(id >> 12 & 0x3ff),
+		Sequence: int(id & 0xfff),
+	}, nil
+}
+
+func parseSnowflake(str string) (uint64, string, int) {
+	if str == "" {
+		return 0, CodeEmpty, -1
+	}
+	for i := 0; i < len(str); i++ {
+		if str[i] < '0' || str[i] > '9' || i == 0 && str[i] == '0' {
+			return 0, CodeInvalidChar, i
+		}
+	}
+	id, err := strconv.ParseUint(str, 10, 63)
+	if err != nil {
+		return 0, CodeTooLong, -1
+	}
+	return id, "", 0
+}
+
+// MongoIDParts holds a parsed MongoDB ObjectId.
+type MongoIDParts struct {
+	// Time is the time of creation, with a resolution of a second.
+	Time time.Time
+	// Random is the 5-byte value random to the machine and process.
+	Random [5]byte
+	// Counter is the 24-bit counter, starting at a random value.
+	Counter uint32
+}
+
+// MongoID check if the string is a valid hex-encoded representation of a MongoDB ObjectId.
+func MongoID(str string) bool {
+	_, code, _ := parseMongoID(str)
+	return code == ""
+}
+
+// ParseMongoID parses a MongoDB ObjectId of 24 hexadecimal digits, such as
+// 507f1f77bcf86cd799439011, into its 4-byte time in seconds, 5-byte random
+// value and 3-byte counter. On failure the error is a *ValidationError.
+func ParseMongoID(str string) (MongoIDParts, error) {
+	b, code, offset := parseMongoID(str)
+	if code != "" {
+		return MongoIDParts{}, invalid("MongoID", code, offset)
+	}
+	p := MongoIDParts{
+		Time:    time.Unix(int64(bigEndian(b[:4])), 0).UTC(),
+		Counter: uint32(bigEndian(b[9:])),
+	}
+	copy(p.Random[:], b[4:9])
+	return p, nil
+}
+
+// MongoIDBetween check if the string is a MongoDB ObjectId created between
+// from and to, inclusive. A zero from or to leaves that end of the range
+// open.
+func MongoIDBetween(str string, from, to time.Time) bool {
+	p, err := ParseMongoID(str)
+	if err != nil {
+		return false
+	}
+	return (from.IsZero() || !p.Time.Before(from)) && (to.IsZero() || !p.Time.After(to))
+}
+
+func parseMongoID(str string) ([12]byte, string, int) {
+	var b [12]byte
+	if code, offset := fixedLength(str, 24); code != "" {
+		return b, code, offset
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		var v byte
+		switch {
+		case '0' <= c && c <= '9':
+			v = c - '0'
+		case 'a' <= c && c <= 'f':
+			v = c - 'a' + 10
+		case 'A' <= c && c <= 'F':
+			v = c - 'A' + 10
+		default:
+			return b, CodeInvalidChar, i
+		}
+		if i%2 == 0 {
+			v <<= 4
+		}
+		b[i/2] |= v
+	}
+	return b, "", 0
+}
+
+// NanoIDOptions describes the NanoIDs accepted by NanoIDWith. The zero value
+// accepts the default NanoIDs of 21 characters of A-Za-z0-9_-.
+type NanoIDOptions struct {
+	// Alphabet lists the allowed ASCII characters. Empty means the URL-safe
+	// alphabet A-Za-z0-9_-.
+	Alphabet string
+	// Length is the number of characters. Zero means 21.
+	Length int
+}
+
+// nanoIDAlphabet is the default alphabet of NanoID.
+const nanoIDAlphabet = "_-0123456789abcdefghijklmnopqrstuvwxyzABCDEFGHIJKLMNOPQRSTUVWXYZ"
+
+// NanoID check if the string is a NanoID of the default form, 21 characters
+// of the URL-safe alphabet A-Za-z0-9_-.
+func NanoID(str string) bool {
+	return NanoIDWith(str, NanoIDOptions{})
+}
+
+// NanoIDWith check if the string is a NanoID of the length and alphabet of
+// opts.
+func NanoIDWith(str string, opts NanoIDOptions) bool {
+	alphabet, length := opts.Alphabet, opts.Length
+	if alphabet == "" {
+		alphabet = nanoIDAlphabet
+	}
+	if length == 0 {
+		length = 21
+	}
+	if len(str) != length {
+		return false
+	}
+	for i := 0; i < len(str); i++ {
+		if strings.IndexByte(alphabet, str[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// CUID2 check if the string is a CUID2 such as tz4a98xxat96iws9zmbrgj3a: a
+// lower case letter followed by lower case letters and digits, 2 to 32
+// characters in all, 24 by default. CUID2s embed no time.
+func CUID2(str string) bool {
+	if len(str) < 2 || len(str) > 32 || str[0] < 'a' || str[0] > 'z' {
+		return false
+	}
+	for i := 1; i < len(str); i++ {
+		if c := str[i]; (c < 'a' || c > 'z') && (c < '0' || c > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+// decodeBase32 writes to dst the bits of the 5-bit digits, most significant
+// first, after dropping the skip high bits of the first digit.
+func decodeBase32(dst, digits []byte, skip int) {
+	var acc uint
+	bits, n := -skip, 0
+	for _, d := range digits {
+		acc = acc<<5 | uint(d)
+		bits += 5
+		if bits >= 8 && n < len(dst) {
+			bits -= 8
+			dst[n] = byte(acc >> uint(bits))
+			n++
+		}
+		acc &= 1<<uint(bits) - 1
+	}
+}
+
+// bigEndian returns the unsigned big-endian integer of up to 8 bytes.
+func bigEndian(b []byte) uint64 {
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v
+}
+
+// upperASCII returns c in upper case if it is an ASCII letter.
+func upperASCII(c byte) byte {
+	if 'a' <= c && c <= 'z' {
+		return c - 'a' + 'A'
+	}
+	return c
+}
+
+// unixMilli returns the UTC time of ms milliseconds since the Unix epoch.
+func unixMilli(ms int64) time.Time {
+	return time.Unix(ms/1e3, ms%1e3*1e6).UTC()
+}
@@ -0,0 +1,233 @@
+package is
+
+import (
+	"testing"
+	"time"
+)
+
+func TestIDs(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) bool
+		name     string
+		param    string
+		expected bool
+	}{
+		{ULID, "ULID", "01ARZ3NDEKTSV4RRFFQ69G5FAV", true},
+		{ULID, "ULID", "01arz3ndektsv4rrffq69g5fav", true},
+		{ULID, "ULID", "7ZZZZZZZZZZZZZZZZZZZZZZZZZ", true},
+		{ULID, "ULID", "00000000000000000000000000", true},
+		{ULID, "ULID", "8ZZZZZZZZZZZZZZZZZZZZZZZZZ", false},
+		{ULID, "ULID", "01ARZ3NDEKTSV4RRFFQ69G5FAU", false},
+		{ULID, "ULID", "01ARZ3NDEKTSV4RRFFQ69G5FA", false},
+		{ULID, "ULID", "", false},
+		{KSUID, "KSUID", "0ujtsYcgvSTl8PAuAdqWYSMnLOv", true},
+		{KSUID, "KSUID", "000000000000000000000000000", true},
+		{KSUID, "KSUID", "aWgEPTl1tmebfsQzFP4bxwgy80V", true},
+		{KSUID, "KSUID", "aWgEPTl1tmebfsQzFP4bxwgy80W", false},
+		{KSUID, "KSUID", "zzzzzzzzzzzzzzzzzzzzzzzzzzz", false},
+		{KSUID, "KSUID", "0ujtsYcgvSTl8PAuAdqWYSMnLO-", false},
+		{KSUID, "KSUID", "0ujtsYcgvSTl8PAuAdqWYSMnLO", false},
+		{XID, "XID", "9m4e2mr0ui3e8a215n4g", true},
+		{XID, "XID", "00000000000000000000", true},
+		{XID, "XID", "9m4e2mr0ui3e8a215n4h", false},
+		{XID, "XID", "9M4E2MR0UI3E8A215N4G", false},
+		{XID, "XID", "9m4e2mr0ui3e8a215nwg", false},
+		{XID, "XID", "9m4e2mr0ui3e8a215n4", false},
+		{Snowflake, "Snowflake", "1541815603606036480", true},
+		{Snowflake, "Snowflake", "175928847299117063", true},
+		{Snowflake, "Snowflake", "1", true},
+		{Snowflake, "Snowflake", "9223372036854775807", true},
+		{Snowflake, "Snowflake", "9223372036854775808", false},
+		{Snowflake, "Snowflake", "0175928847299117063", false},
+		{Snowflake, "Snowflake", "0", false},
+		{Snowflake, "Snowflake", "-175928847299117063", false},
+		{Snowflake, "Snowflake", "", false},
+		{NanoID, "NanoID", "V1StGXR8_Z5jdHi6B-myT", true},
+		{NanoID, "NanoID", "V1StGXR8_Z5jdHi6B-my", false},
+		{NanoID, "NanoID", "V1StGXR8_Z5jdHi6B-my+", false},
+		{NanoID, "NanoID", "", false},
+		{CUID2, "CUID2", "tz4a98xxat96iws9zmbrgj3a", true},
+		{CUID2, "CUID2", "pfh0haxfpzowht3oi213cqos", true},
+		{CUID2, "CUID2", "ab", true},
+		{CUID2, "CUID2", "a", false},
+		{CUID2, "CUID2", "1z4a98xxat96iws9zmbrgj3a", false},
+		{CUID2, "CUID2", "Tz4a98xxat96iws9zmbrgj3a", false},
+		{CUID2, "CUID2", "tz4a98xxat96iws9zmbrgj3a-", false},
+		{CUID2, "CUID2", "tz4a98xxat96iws9zmbrgj3atz4a98xxa", false},
+	}
+	for _, test := range tests {
+		if actual := test.function(test.param); actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestNanoIDWith(t *testing.T) {
+	t.Parallel()
+
+	hex := NanoIDOptions{Alphabet: "0123456789abcdef", Length: 12}
+	var tests = []struct {
+		param    string
+		opts     NanoIDOptions
+		expected bool
+	}{
+		{"4f90d13a42bc", hex, true},
+		{"4f90d13a42bg", hex, false},
+		{"4f90d13a42b", hex, false},
+		{"4f90d13a42bc4f90d13a4", NanoIDOptions{}, true},
+		{"4f90d13a42bc4f90d13a4", NanoIDOptions{Length: 10}, false},
+		{"4f90d13a42", NanoIDOptions{Length: 10}, true},
+	}
+	for _, test := range tests {
+		if actual := NanoIDWith(test.param, test.opts); actual != test.expected {
+			t.Errorf("Expected NanoIDWith(%q, %+v) to be %v, got %v", test.param, test.opts, test.expected, actual)
+		}
+	}
+}
+
+func TestParseIDs(t *testing.T) {
+	t.Parallel()
+
+	u, err := ParseULID("01ARZ3NDEKTSV4RRFFQ69G5FAV")
+	if want := time.Date(2016, 7, 30, 23, 54, 10, 259e6, time.UTC); err != nil || !u.Time.Equal(want) {
+		t.Errorf("Expected ParseULID time to be %v, got %v, %v", want, u.Time, err)
+	}
+	if want := [16]byte{0x01, 0x56, 0x3e, 0x3a, 0xb5, 0xd3, 0xd6, 0x76, 0x4c, 0x61, 0xef, 0xb9, 0x93, 0x02, 0xbd, 0x5b}; u.Bytes != want {
+		t.Errorf("Expected ParseULID bytes to be %x, got %x", want, u.Bytes)
+	}
+
+	k, err := ParseKSUID("0ujtsYcgvSTl8PAuAdqWYSMnLOv")
+	if want := time.Date(2017, 10, 10, 4, 0, 47, 0, time.UTC); err != nil || !k.Time.Equal(want) {
+		t.Errorf("Expected ParseKSUID time to be %v, got %v, %v", want, k.Time, err)
+	}
+	if want := [16]byte{0xb5, 0xa1, 0xcd, 0x34, 0xb5, 0xf9, 0x9d, 0x11, 0x54, 0xfb, 0x68, 0x53, 0x34, 0x5c, 0x97, 0x35}; k.Payload != want {
+		t.Errorf("Expected ParseKSUID payload to be %x, got %x", want, k.Payload)
+	}
+
+	x, err := ParseXID("9m4e2mr0ui3e8a215n4g")
+	if err != nil {
+		t.Fatalf("Expected ParseXID to succeed, got %v", err)
+	}
+	if want := (XIDParts{
+		Time:    time.Date(2011, 3, 22, 17, 50, 19, 0, time.UTC),
+		Machine: [3]byte{0x60, 0xf4, 0x86},
+		PID:     58408,
+		Counter: 4271561,
+	}); !x.Time.Equal(want.Time) || x.Machine != want.Machine || x.PID != want.PID || x.Counter != want.Counter {
+		t.Errorf("Expected ParseXID to return %+v, got %+v", want, x)
+	}
+
+	var snowflakes = []struct {
+		param    string
+		epoch    time.Time
+		expected SnowflakeParts
+	}{
+		{"175928847299117063", DiscordEpoch, SnowflakeParts{time.Date(2016, 4, 30, 11, 18, 25, 796e6, time.UTC), 32, 7}},
+		{"1541815603606036480", TwitterEpoch, SnowflakeParts{time.Date(2022, 6, 28, 16, 7, 40, 105e6, time.UTC), 378, 0}},
+	}
+	for _, test := range snowflakes {
+		s, err := ParseSnowflake(test.param, test.epoch)
+		if err != nil || !s.Time.Equal(test.expected.Time) || s.Node != test.expected.Node || s.Sequence != test.expected.Sequence {
+			t.Errorf("Expected ParseSnowflake(%q) to return %+v, got %+v, %v", test.param, test.expected, s, err)
+		}
+	}
+}
+
+func TestParseIDsErrors(t *testing.T) {
+	t.Parallel()
+
+	parseULID := func(s string) error { _, err := ParseULID(s); return err }
+	parseKSUID := func(s string) error { _, err := ParseKSUID(s); return err }
+	parseXID := func(s string) error { _, err := ParseXID(s); return err }
+	parseSnowflake := func(s string) error { _, err := ParseSnowflake(s, TwitterEpoch); return err }
+	var tests = []struct {
+		function func(string) error
+		name     string
+		param    string
+		code     string
+		offset   int
+	}{
+		{parseULID, "ULID", "", CodeEmpty, -1},
+		{parseULID, "ULID", "01ARZ3NDEKTSV4RRFFQ69G5FA", CodeTooShort, -1},
+		{parseULID, "ULID", "01ARZ3NDEKTSV4RRFFQ69G5FAVX", CodeTooLong, 26},
+		{parseULID, "ULID", "01ARZ3NDEKTSV4RRFFQ69G5FAU", CodeInvalidChar, 25},
+		{parseULID, "ULID", "81ARZ3NDEKTSV4RRFFQ69G5FAV", CodeBadFormat, 0},
+		{parseKSUID, "KSUID", "0ujtsYcgvSTl8PAuAdqWYSMnLO-", CodeInvalidChar, 26},
+		{parseKSUID, "KSUID", "aWgEPTl1tmebfsQzFP4bxwgy80W", CodeBadFormat, 0},
+		{parseXID, "XID", "9m4e2mr0ui3e8a215n4w", CodeInvalidChar, 19},
+		{parseXID, "XID", "9m4e2mr0ui3e8a215n4h", CodeBadFormat, 19},
+		{parseSnowflake, "Snowflake", "", CodeEmpty, -1},
+		{parseSnowflake, "Snowflake", "0175928847299117063", CodeInvalidChar, 0},
+		{parseSnowflake, "Snowflake", "17592884729911706x", CodeInvalidChar, 17},
+		{parseSnowflake, "Snowflake", "9223372036854775808", CodeTooLong, -1},
+	}
+	for _, test := range tests {
+		err := test.function(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != test.name || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected Parse%s(%q) to fail with %s at %d, got %v", test.name, test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestParseMongoID(t *testing.T) {
+	t.Parallel()
+
+	p, err := ParseMongoID("507F1F77BCF86CD799439011")
+	if err != nil {
+		t.Fatalf("Expected ParseMongoID to succeed, got %v", err)
+	}
+	if want := (MongoIDParts{
+		Time:    time.Date(2012, 10, 17, 21, 13, 27, 0, time.UTC),
+		Random:  [5]byte{0xbc, 0xf8, 0x6c, 0xd7, 0x99},
+		Counter: 4427793,
+	}); !p.Time.Equal(want.Time) || p.Random != want.Random || p.Counter != want.Counter {
+		t.Errorf("Expected ParseMongoID to return %+v, got %+v", want, p)
+	}
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"507f1f77bcf86cd7994390", CodeTooShort, -1},
+		{"507f1f77bcf86cd7994390111", CodeTooLong, 24},
+		{"507f1f77bcf86cd79943901z", CodeInvalidChar, 23},
+	}
+	for _, test := range tests {
+		_, err := ParseMongoID(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "MongoID" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseMongoID(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestMongoIDBetween(t *testing.T) {
+	t.Parallel()
+
+	created := time.Date(2012, 10, 17, 21, 13, 27, 0, time.UTC)
+	var tests = []struct {
+		param    string
+		from     time.Time
+		to       time.Time
+		expected bool
+	}{
+		{"507f1f77bcf86cd799439011", created.Add(-time.Hour), created.Add(time.Hour), true},
+		{"507f1f77bcf86cd799439011", created, created, true},
+		{"507f1f77bcf86cd799439011", created.Add(time.Second), created.Add(time.Hour), false},
+		{"507f1f77bcf86cd799439011", created.Add(-time.Hour), created.Add(-time.Second), false},
+		{"507f1f77bcf86cd799439011", time.Time{}, created, true},
+		{"507f1f77bcf86cd799439011", created, time.Time{}, true},
+		{"507f1f77bcf86cd799439011", time.Time{}, time.Time{}, true},
+		{"507f1f77bcf86cd79943901z", time.Time{}, time.Time{}, false},
+	}
+	for _, test := range tests {
+		if actual := MongoIDBetween(test.param, test.from, test.to); actual != test.expected {
+			t.Errorf("Expected MongoIDBetween(%q, %v, %v) to be %v, got %v", test.param, test.from, test.to, test.expected, actual)
+		}
+	}
+}
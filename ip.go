@@ -0,0 +1,224 @@
+package is
+
+import (
+	"net"
+	"strings"
+)
+
+// IPCategory is a set of address categories reported by IPClass.
+type IPCategory uint32
+
+// Address categories of the IANA special-purpose registries.
+const (
+	// IPThisNetwork is 0.0.0.0/8 (RFC 791).
+	IPThisNetwork IPCategory = 1 << iota
+	// IPUnspecified is 0.0.0.0 and :: (RFC 1122, RFC 4291).
+	IPUnspecified
+	// IPPrivate is private-use space: 10/8, 172.16/12, 192.168/16 (RFC 1918) and fc00::/7 (RFC 4193).
+	IPPrivate
+	// IPShared is the carrier-grade NAT space 100.64.0.0/10 (RFC 6598).
+	IPShared
+	// IPLoopback is 127/8 and ::1 (RFC 1122, RFC 4291).
+	IPLoopback
+	// IPLinkLocal is 169.254/16 and fe80::/10 (RFC 3927, RFC 4291).
+	IPLinkLocal
+	// IPMulticast is 224/4 and ff00::/8 (RFC 5771, RFC 4291).
+	IPMulticast
+	// IPBroadcast is the limited broadcast address 255.255.255.255 (RFC 919).
+	IPBroadcast
+	// IPDocumentation is TEST-NET-1/2/3, 2001:db8::/32 and 3fff::/20 (RFC 5737, RFC 3849, RFC 9637).
+	IPDocumentation
+	// IPBenchmarking is 198.18/15 and 2001:2::/48 (RFC 2544, RFC 5180).
+	IPBenchmarking
+	// IPReserved is the reserved block 240/4 (RFC 1112).
+	IPReserved
+	// IPProtocolAssignment is 192.0.0.0/24 and 2001::/23 (RFC 6890, RFC 2928).
+	IPProtocolAssignment
+	// IPv4Mapped is the IPv4-mapped IPv6 block ::ffff:0:0/96 (RFC 4291).
+	IPv4Mapped
+	// IPTranslation is the IPv4/IPv6 translation prefixes 64:ff9b::/96 and 64:ff9b:1::/48 (RFC 6052, RFC 8215).
+	IPTranslation
+	// IPDiscardOnly is the discard prefix 100::/64 (RFC 6666).
+	IPDiscardOnly
+	// IPTeredo is 2001::/32 (RFC 4380).
+	IPTeredo
+	// IP6to4 is 2002::/16 (RFC 3056).
+	IP6to4
+	// IPORCHID is ORCHIDv2 2001:20::/28 (RFC 7343).
+	IPORCHID
+	// IPAS112 is the AS112 DNS service blocks (RFC 7534, RFC 7535).
+	IPAS112
+	// IPAMT is Automatic Multicast Tunneling, 192.52.193.0/24 and 2001:3::/32 (RFC 7450).
+	IPAMT
+	// IPSegmentRouting is the SRv6 SID block 5f00::/16 (RFC 9602).
+	IPSegmentRouting
+	// IPDroneRemoteID is 2001:30::/28 (RFC 9374).
+	IPDroneRemoteID
+)
+
+var ipCategoryNames = []string{
+	"this-network", "unspecified", "private", "shared", "loopback", "link-local",
+	"multicast", "broadcast", "documentation", "benchmarking", "reserved",
+	"protocol-assignment", "ipv4-mapped", "translation", "discard-only", "teredo",
+	"6to4", "orchid", "as112", "amt", "segment-routing", "drone-remote-id",
+}
+
+// Has reports whether c contains every category of o.
+func (c IPCategory) Has(o IPCategory) bool {
+	return c&o == o
+}
+
+// String returns the category names separated by "|".
+func (c IPCategory) String() string {
+	var names []string
+	for i, name := range ipCategoryNames {
+		if c&(1<<uint(i)) != 0 {
+			names = append(names, name)
+		}
+	}
+	return strings.Join(names, "|")
+}
+
+// IPSpecialEntry stores a block of the IANA special-purpose address registries
+type IPSpecialEntry struct {
+	Block    string
+	Name     string
+	RFC      string
+	Category IPCategory
+	// Global is the registry's "Globally Reachable" column; N/A is stored as false.
+	Global bool
+}
+
+// IPSpecialList based on https://www.iana.org/assignments/iana-ipv4-special-registry/
+// and https://www.iana.org/assignments/iana-ipv6-special-registry/, plus the
+// multicast blocks of https://www.iana.org/assignments/multicast-addresses/
+var IPSpecialList = []IPSpecialEntry{
+	{"0.0.0.0/8", "\"This network\"", "RFC 791", IPThisNetwork, false},
+	{"0.0.0.0/32", "\"This host on this network\"", "RFC 1122", IPThisNetwork | IPUnspecified, false},
+	{"10.0.0.0/8", "Private-Use", "RFC 1918", IPPrivate, false},
+	{"100.64.0.0/10", "Shared Address Space", "RFC 6598", IPShared, false},
+	{"127.0.0.0/8", "Loopback", "RFC 1122", IPLoopback, false},
+	{"169.254.0.0/16", "Link Local", "RFC 3927", IPLinkLocal, false},
+	{"172.16.0.0/12", "Private-Use", "RFC 1918", IPPrivate, false},
+	{"192.0.0.0/24", "IETF Protocol Assignments", "RFC 6890", IPProtocolAssignment, false},
+	{"192.0.0.0/29", "IPv4 Service Continuity Prefix", "RFC 7335", IPProtocolAssignment, false},
+	{"192.0.0.8/32", "IPv4 dummy address", "RFC 7600", IPProtocolAssignment, false},
+	{"192.0.0.9/32", "Port Control Protocol Anycast", "RFC 7723", IPProtocolAssignment, true},
+	{"192.0.0.10/32", "Traversal Using Relays around NAT Anycast", "RFC 8155", IPProtocolAssignment, true},
+	{"192.0.0.170/32", "NAT64/DNS64 Discovery", "RFC 7050", IPProtocolAssignment, false},
+	{"192.0.0.171/32", "NAT64/DNS64 Discovery", "RFC 7050", IPProtocolAssignment, false},
+	{"192.0.2.0/24", "Documentation (TEST-NET-1)", "RFC 5737", IPDocumentation, false},
+	{"192.31.196.0/24", "AS112-v4", "RFC 7535", IPAS112, true},
+	{"192.52.193.0/24", "AMT", "RFC 7450", IPAMT, true},
+	{"192.168.0.0/16", "Private-Use", "RFC 1918", IPPrivate, false},
+	{"192.175.48.0/24", "Direct Delegation AS112 Service", "RFC 7534", IPAS112, true},
+	{"198.18.0.0/15", "Benchmarking", "RFC 2544", IPBenchmarking, false},
+	{"198.51.100.0/24", "Documentation (TEST-NET-2)", "RFC 5737", IPDocumentation, false},
+	{"203.0.113.0/24", "Documentation (TEST-NET-3)", "RFC 5737", IPDocumentation, false},
+	{"224.0.0.0/4", "Multicast", "RFC 5771", IPMulticast, false},
+	{"240.0.0.0/4", "Reserved", "RFC 1112", IPReserved, false},
+	{"255.255.255.255/32", "Limited Broadcast", "RFC 919", IPReserved | IPBroadcast, false},
+	{"::1/128", "Loopback Address", "RFC 4291", IPLoopback, false},
+	{"::/128", "Unspecified Address", "RFC 4291", IPUnspecified, false},
+	{"::ffff:0:0/96", "IPv4-mapped Address", "RFC 4291", IPv4Mapped, false},
+	{"64:ff9b::/96", "IPv4-IPv6 Translat.", "RFC 6052", IPTranslation, true},
+	{"64:ff9b:1::/48", "IPv4-IPv6 Translat.", "RFC 8215", IPTranslation, false},
+	{"100::/64", "Discard-Only Address Block", "RFC 6666", IPDiscardOnly, false},
+	{"2001::/23", "IETF Protocol Assignments", "RFC 2928", IPProtocolAssignment, false},
+	{"2001::/32", "TEREDO", "RFC 4380", IPProtocolAssignment | IPTeredo, false},
+	{"2001:1::1/128", "Port Control Protocol Anycast", "RFC 7723", IPProtocolAssignment, true},
+	{"2001:1::2/128", "Traversal Using Relays around NAT Anycast", "RFC 8155", IPProtocolAssignment, true},
+	{"2001:1::3/128", "DNS-SD Service Registration Protocol Anycast", "RFC 9665", IPProtocolAssignment, true},
+	{"2001:2::/48", "Benchmarking", "RFC 5180", IPProtocolAssignment | IPBenchmarking, false},
+	{"2001:3::/32", "AMT", "RFC 7450", IPProtocolAssignment | IPAMT, true},
+	{"2001:4:112::/48", "AS112-v6", "RFC 7535", IPProtocolAssignment | IPAS112, true},
+	{"2001:20::/28", "ORCHIDv2", "RFC 7343", IPProtocolAssignment | IPORCHID, true},
+	{"2001:30::/28", "Drone Remote ID Protocol Entity Tags (DETs) Prefix", "RFC 9374", IPProtocolAssignment | IPDroneRemoteID, true},
+	{"2001:db8::/32", "Documentation", "RFC 3849", IPDocumentation, false},
+	{"2002::/16", "6to4", "RFC 3056", IP6to4, false},
+	{"2620:4f:8000::/48", "Direct Delegation AS112 Service", "RFC 7534", IPAS112, true},
+	{"3fff::/20", "Documentation", "RFC 9637", IPDocumentation, false},
+	{"5f00::/16", "Segment Routing (SRv6) SIDs", "RFC 9602", IPSegmentRouting, false},
+	{"fc00::/7", "Unique-Local", "RFC 4193", IPPrivate, false},
+	{"fe80::/10", "Link-Local Unicast", "RFC 4291", IPLinkLocal, false},
+	{"ff00::/8", "Multicast", "RFC 4291", IPMulticast, false},
+}
+
+// ipSpecialNets holds the parsed blocks of IPSpecialList, in the same order.
+var ipSpecialNets = parseIPSpecialList()
+
+func parseIPSpecialList() []*net.IPNet {
+	nets := make([]*net.IPNet, len(IPSpecialList))
+	for i, entry := range IPSpecialList {
+		_, n, err := net.ParseCIDR(entry.Block)
+		if err != nil {
+			panic("is: bad IPSpecialList block " + entry.Block)
+		}
+		nets[i] = n
+	}
+	return nets
+}
+
+// IPClass returns every special-purpose category of the IP address str, or
+// false if str is not an IP address. Ordinary global unicast addresses have
+// no category. An IPv4-mapped IPv6 address such as ::ffff:10.0.0.1 reports
+// IPv4Mapped along with the categories of the IPv4 address it carries.
+func IPClass(str string) (IPCategory, bool) {
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return 0, false
+	}
+	c, _ := classifyIP(ip, strings.Contains(str, ":"))
+	return c, true
+}
+
+// PrivateIP check if the string is a private-use (RFC 1918, RFC 4193) or
+// shared (RFC 6598, carrier-grade NAT) IP address, including when it is
+// wrapped in an IPv4-mapped IPv6 address.
+func PrivateIP(str string) bool {
+	c, ok := IPClass(str)
+	return ok && c&(IPPrivate|IPShared) != 0
+}
+
+// PublicIP check if the string is a globally reachable IP address: it is
+// outside every special-purpose block, or the most specific block containing
+// it is marked globally reachable by IANA. IPv4-mapped IPv6 addresses are
+// judged by the IPv4 address they carry.
+func PublicIP(str string) bool {
+	ip := net.ParseIP(str)
+	if ip == nil {
+		return false
+	}
+	_, global := classifyIP(ip, strings.Contains(str, ":"))
+	return global
+}
+
+// globalIP is PublicIP for a parsed address.
+func globalIP(ip net.IP) bool {
+	_, global := classifyIP(ip, false)
+	return global
+}
+
+// classifyIP returns the categories of ip and whether it is globally reachable.
+// mapped tells whether a 4-in-6 address was written in IPv6 notation.
+func classifyIP(ip net.IP, mapped bool) (IPCategory, bool) {
+	var c IPCategory
+	if mapped && ip.To4() != nil {
+		c = IPv4Mapped
+	}
+	global := true
+	bits := -1
+	for i, n := range ipSpecialNets {
+		// net.IPNet treats ::ffff:0:0/96 as 0.0.0.0/0, so 4-in-6 addresses
+		// are recognized by their notation instead
+		if IPSpecialList[i].Category == IPv4Mapped || !n.Contains(ip) {
+			continue
+		}
+		c |= IPSpecialList[i].Category
+		if ones, _ := n.Mask.Size(); ones > bits {
+			bits = ones
+			global = IPSpecialList[i].Global
+		}
+	}
+	return c, global
+}
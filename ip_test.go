@@ -0,0 +1,134 @@
+package is
+
+import "testing"
+
+func TestIPClass(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected IPCategory
+		ok       bool
+	}{
+		{"", 0, false},
+		{"300.0.0.0", 0, false},
+		{"8.8.8.8", 0, true},
+		{"2606:4700::1111", 0, true},
+		{"10.1.2.3", IPPrivate, true},
+		{"172.31.255.255", IPPrivate, true},
+		{"172.32.0.1", 0, true},
+		{"192.168.1.1", IPPrivate, true},
+		{"100.64.0.1", IPShared, true},
+		{"100.128.0.1", 0, true},
+		{"127.0.0.1", IPLoopback, true},
+		{"::1", IPLoopback, true},
+		{"169.254.169.254", IPLinkLocal, true},
+		{"fe80::1", IPLinkLocal, true},
+		{"224.0.0.251", IPMulticast, true},
+		{"ff02::fb", IPMulticast, true},
+		{"0.0.0.0", IPThisNetwork | IPUnspecified, true},
+		{"::", IPUnspecified, true},
+		{"192.0.2.1", IPDocumentation, true},
+		{"198.51.100.7", IPDocumentation, true},
+		{"203.0.113.9", IPDocumentation, true},
+		{"2001:db8::1", IPDocumentation, true},
+		{"198.19.0.1", IPBenchmarking, true},
+		{"250.1.2.3", IPReserved, true},
+		{"255.255.255.255", IPReserved | IPBroadcast, true},
+		{"192.0.0.9", IPProtocolAssignment, true},
+		{"2001::1", IPProtocolAssignment | IPTeredo, true},
+		{"2002:c000:0204::1", IP6to4, true},
+		{"64:ff9b::8.8.8.8", IPTranslation, true},
+		{"fd12:3456::1", IPPrivate, true},
+		{"::ffff:10.0.0.1", IPv4Mapped | IPPrivate, true},
+		{"::ffff:8.8.8.8", IPv4Mapped, true},
+	}
+	for _, test := range tests {
+		actual, ok := IPClass(test.param)
+		if actual != test.expected || ok != test.ok {
+			t.Errorf("Expected IPClass(%q) to be %v, %v, got %v, %v", test.param, test.expected, test.ok, actual, ok)
+		}
+	}
+}
+
+func TestPublicIP(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"foo", false},
+		{"8.8.8.8", true},
+		{"2606:4700::1111", true},
+		{"10.0.0.1", false},
+		{"100.64.0.1", false},
+		{"127.0.0.1", false},
+		{"169.254.169.254", false},
+		{"224.0.0.1", false},
+		{"0.0.0.0", false},
+		{"192.0.2.1", false},
+		{"192.0.0.1", false},
+		{"192.0.0.9", true},
+		{"192.31.196.1", true},
+		{"2001:db8::1", false},
+		{"2001:1::1", true},
+		{"2001:1::4", false},
+		{"fc00::1", false},
+		{"::ffff:8.8.8.8", true},
+		{"::ffff:127.0.0.1", false},
+	}
+	for _, test := range tests {
+		actual := PublicIP(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected PublicIP(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestPrivateIP(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"8.8.8.8", false},
+		{"127.0.0.1", false},
+		{"10.0.0.1", true},
+		{"172.16.0.1", true},
+		{"192.168.0.1", true},
+		{"100.100.100.100", true},
+		{"fd00::1", true},
+		{"::ffff:192.168.0.1", true},
+		{"::ffff:8.8.8.8", false},
+	}
+	for _, test := range tests {
+		actual := PrivateIP(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected PrivateIP(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIPCategoryString(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    IPCategory
+		expected string
+	}{
+		{0, ""},
+		{IPPrivate, "private"},
+		{IPv4Mapped | IPLoopback, "loopback|ipv4-mapped"},
+		{IPDroneRemoteID, "drone-remote-id"},
+	}
+	for _, test := range tests {
+		actual := test.param.String()
+		if actual != test.expected {
+			t.Errorf("Expected IPCategory(%d).String() to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
@@ -7,11 +7,12 @@ import (
 	"net"
 	"net/url"
 	"os"
-	"regexp"
 	"strconv"
 	"strings"
 	"unicode"
 	"unicode/utf8"
+
+	"github.com/alioygur/is/checkdigit"
 )
 
 // InRange returns true if value lies between left and right border
@@ -22,33 +23,50 @@ func InRange(value, left, right float64) bool {
 	return value >= left && value <= right
 }
 
-// Email is a constraint to do a simple validation for email addresses, it only check if the string contains "@"
-// and that it is not in the first or last character of the string
-// https://en.wikipedia.org/wiki/Email_address#Valid_email_addresses
+// Email check if the string is an email address as defined by RFC 5321,
+// e.g. john.doe@example.com, "john doe"@example.com or john@[192.0.2.1].
+// See ParseEmail for details.
 func Email(s string) bool {
-	if !strings.Contains(s, "@") || s[0] == '@' || s[len(s)-1] == '@' {
-		return false
-	}
-	return true
+	return CheckEmail(s) == nil
+}
+
+// CheckEmail is like Email but returns a *ValidationError explaining why s was rejected.
+func CheckEmail(s string) error {
+	_, err := ParseEmail(s)
+	return err
 }
 
 // URL check if the string is an URL.
 func URL(str string) bool {
-	if str == "" || len(str) >= 2083 || len(str) <= 3 || strings.HasPrefix(str, ".") {
-		return false
+	return CheckURL(str) == nil
+}
+
+// CheckURL is like URL but returns a *ValidationError explaining why str was rejected.
+func CheckURL(str string) error {
+	switch {
+	case str == "":
+		return invalid("URL", CodeEmpty, -1)
+	case len(str) <= 3:
+		return invalid("URL", CodeTooShort, -1)
+	case len(str) >= 2083:
+		return invalid("URL", CodeTooLong, -1)
+	case strings.HasPrefix(str, "."):
+		return invalid("URL", CodeInvalidChar, 0)
 	}
 	u, err := url.Parse(str)
 	if err != nil {
-		return false
+		return invalid("URL", CodeBadFormat, -1)
 	}
 	if strings.HasPrefix(u.Host, ".") {
-		return false
+		return invalid("URL", CodeInvalidChar, strings.Index(str, u.Host))
 	}
 	if u.Host == "" && (u.Path != "" && !strings.Contains(u.Path, ".")) {
-		return false
+		return invalid("URL", CodeBadFormat, -1)
 	}
-	return rxURL.MatchString(str)
-
+	if !rxURL.MatchString(str) {
+		return invalid("URL", CodeBadFormat, -1)
+	}
+	return nil
 }
 
 // RequestURL check if the string rawurl, assuming
@@ -249,78 +267,52 @@ func ByteLength(str string, min, max int) bool {
 	return len(str) >= min && len(str) <= max
 }
 
-// UUIDv3 check if the string is a UUID version 3.
-func UUIDv3(str string) bool {
-	return UUID(str) && str[14] == '3'
-}
-
-// UUIDv4 check if the string is a UUID version 4.
-func UUIDv4(str string) bool {
-	return UUID(str) &&
-		str[14] == '4' &&
-		(str[19] == '8' || str[19] == '9' || str[19] == 'a' || str[19] == 'b')
+// CreditCard check if the string is a credit card number of one of the brands
+// known to CardBrand, with a valid Luhn check digit.
+func CreditCard(str string) bool {
+	code, _ := creditCard(str)
+	return code == ""
 }
 
-// UUIDv5 check if the string is a UUID version 5.
-func UUIDv5(str string) bool {
-	return UUID(str) &&
-		str[14] == '5' &&
-		(str[19] == '8' || str[19] == '9' || str[19] == 'a' || str[19] == 'b')
+// CheckCreditCard is like CreditCard but returns a *ValidationError explaining why str was rejected.
+func CheckCreditCard(str string) error {
+	if code, offset := creditCard(str); code != "" {
+		return invalid("CreditCard", code, offset)
+	}
+	return nil
 }
 
-// UUID check if the string is a UUID (version 3, 4 or 5).
-func UUID(str string) bool {
-	if len(str) != 36 {
-		return false
+// creditCard validates str in a single pass without allocating. Characters other
+// than digits are ignored. It returns the error code and offset, or "" if str is valid.
+func creditCard(str string) (string, int) {
+	if str == "" {
+		return CodeEmpty, -1
 	}
-
-	for i, c := range str {
-		if i == 8 || i == 13 || i == 18 || i == 23 {
-			if c != '-' {
-				return false
-			}
+	var digits [19]byte
+	n, last := 0, -1
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c < '0' || c > '9' {
 			continue
 		}
-
-		if ('f' < c || c < 'a') && ('9' < c || c < '0') {
-			return false
+		if n == len(digits) {
+			return CodeTooLong, -1
 		}
+		digits[n] = c
+		n++
+		last = i
 	}
-
-	return true
-}
-
-// CreditCard check if the string is a credit card.
-func CreditCard(str string) bool {
-	r, _ := regexp.Compile("[^0-9]+")
-	sanitized := r.ReplaceAll([]byte(str), []byte(""))
-	if !rxCreditCard.MatchString(string(sanitized)) {
-		return false
+	if n < 12 {
+		return CodeTooShort, -1
 	}
-	var sum int64
-	var digit string
-	var tmpNum int64
-	var shouldDouble bool
-	for i := len(sanitized) - 1; i >= 0; i-- {
-		digit = string(sanitized[i:(i + 1)])
-		tmpNum, _ = toInt(digit)
-		if shouldDouble {
-			tmpNum *= 2
-			if tmpNum >= 10 {
-				sum += ((tmpNum % 10) + 1)
-			} else {
-				sum += tmpNum
-			}
-		} else {
-			sum += tmpNum
-		}
-		shouldDouble = !shouldDouble
+	if e := cardIIN(digits[:n]); e == nil || !containsInt(e.Lengths, n) {
+		return CodeBadFormat, -1
 	}
-
-	if sum%10 == 0 {
-		return true
+	if !(checkdigit.Luhn{}).Validate(string(digits[:n])) {
+		// the last digit is the check digit
+		return CodeBadChecksum, last
 	}
-	return false
+	return "", 0
 }
 
 // ISBN10 check if the string is an ISBN version 10.
@@ -336,40 +328,76 @@ func ISBN13(str string) bool {
 // ISBN check if the string is an ISBN (version 10 or 13).
 // If version value is not equal to 10 or 13, it will be check both variants.
 func ISBN(str string, version int) bool {
-	r, _ := regexp.Compile("[\\s-]+")
-	sanitized := r.ReplaceAll([]byte(str), []byte(""))
-	var checksum int32
-	var i int32
-	if version == 10 {
-		if !rxISBN10.MatchString(string(sanitized)) {
-			return false
-		}
-		for i = 0; i < 9; i++ {
-			checksum += (i + 1) * int32(sanitized[i]-'0')
-		}
-		if sanitized[9] == 'X' {
-			checksum += 10 * 10
-		} else {
-			checksum += 10 * int32(sanitized[9]-'0')
+	code, _ := isbn(str, version)
+	return code == ""
+}
+
+// CheckISBN is like ISBN but returns a *ValidationError explaining why str was rejected.
+// Spaces and hyphens between the digits are ignored.
+func CheckISBN(str string, version int) error {
+	if code, offset := isbn(str, version); code != "" {
+		return invalid("ISBN", code, offset)
+	}
+	return nil
+}
+
+// isbn validates str in a single pass without allocating. It returns the error
+// code and offset, or "" if str is valid.
+func isbn(str string, version int) (string, int) {
+	if version != 10 && version != 13 {
+		n := 0
+		for i := 0; i < len(str); i++ {
+			if !isbnSeparator(str[i]) {
+				n++
+			}
 		}
-		if checksum%11 == 0 {
-			return true
+		switch {
+		case n == 0:
+			return CodeEmpty, -1
+		case n < 10:
+			return CodeTooShort, -1
+		case n == 10 || n == 13:
+			version = n
+		case n < 13:
+			return CodeBadLength, -1
+		default:
+			return CodeTooLong, -1
 		}
-		return false
-	} else if version == 13 {
-		if !rxISBN13.MatchString(string(sanitized)) {
-			return false
+	}
+
+	var digits [13]byte
+	var n, last int
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if isbnSeparator(c) {
+			continue
 		}
-		factor := []int32{1, 3}
-		for i = 0; i < 12; i++ {
-			checksum += factor[i%2] * int32(sanitized[i]-'0')
+		if n == version {
+			return CodeTooLong, i
 		}
-		if (int32(sanitized[12]-'0'))-((10-(checksum%10))%10) == 0 {
-			return true
+		if (c < '0' || c > '9') && (c != 'X' || version != 10 || n != 9) {
+			return CodeInvalidChar, i
 		}
-		return false
+		digits[n] = c
+		n++
+		last = i
 	}
-	return ISBN(str, 10) || ISBN(str, 13)
+
+	switch {
+	case n == 0:
+		return CodeEmpty, -1
+	case n < version:
+		return CodeTooShort, -1
+	case version == 10 && !checkdigit.WeightedMod11{}.Validate(string(digits[:n])),
+		version == 13 && !checkdigit.GS1{}.Validate(string(digits[:n])):
+		return CodeBadChecksum, last
+	}
+	return "", 0
+}
+
+// isbnSeparator reports whether c may separate the digit groups of an ISBN.
+func isbnSeparator(c byte) bool {
+	return c == '-' || c == ' ' || c == '\t' || c == '\n' || c == '\f' || c == '\r'
 }
 
 // JSON check if the string is valid JSON (note: uses json.Unmarshal).
@@ -489,11 +517,41 @@ func ISO3166Alpha3(str string) bool {
 
 // DNSName will validate the given string as a DNS name
 func DNSName(str string) bool {
-	if str == "" || len(strings.Replace(str, ".", "", -1)) > 255 {
-		// constraints already violated
-		return false
+	return CheckDNSName(str) == nil
+}
+
+// CheckDNSName is like DNSName but returns a *ValidationError explaining why str was rejected.
+// Labels are 1 to 63 characters of letters, digits, hyphens and underscores;
+// they must start with a letter or digit and must not end with a hyphen.
+func CheckDNSName(str string) error {
+	if str == "" {
+		return invalid("DNSName", CodeEmpty, -1)
+	}
+	if len(str)-strings.Count(str, ".") > 255 {
+		return invalid("DNSName", CodeTooLong, -1)
+	}
+
+	start := 0
+	for i := 0; i <= len(str); i++ {
+		if i == len(str) || str[i] == '.' {
+			switch {
+			case i == start:
+				return invalid("DNSName", CodeBadFormat, i)
+			case i-start > 63:
+				return invalid("DNSName", CodeTooLong, start)
+			case str[i-1] == '-':
+				return invalid("DNSName", CodeInvalidChar, i-1)
+			}
+			start = i + 1
+			continue
+		}
+		c := str[i]
+		if ('z' < c || c < 'a') && ('Z' < c || c < 'A') && ('9' < c || c < '0') &&
+			((c != '-' && c != '_') || i == start) {
+			return invalid("DNSName", CodeInvalidChar, i)
+		}
 	}
-	return rxDNSName.MatchString(str)
+	return nil
 }
 
 // DialString validates the given string for usage with the various Dial() functions
@@ -544,21 +602,6 @@ func MAC(str string) bool {
 	return err == nil
 }
 
-// MongoID check if the string is a valid hex-encoded representation of a MongoDB ObjectId.
-func MongoID(str string) bool {
-	if str == "" || len(str) != 24 {
-		return false
-	}
-
-	for _, c := range str {
-		if ('F' < c || c < 'A') && ('f' < c || c < 'a') && ('9' < c || c < '0') {
-			return false
-		}
-	}
-
-	return true
-}
-
 // Latitude check if a string is valid latitude.
 func Latitude(str string) bool {
 	if str == "" {
@@ -595,17 +638,31 @@ func Longitude(str string) bool {
 	return true
 }
 
-// SSN will validate the given string as a U.S. Social Security Number
+// SSN will validate the given string as a U.S. Social Security Number written
+// as 123-45-6789 or 123 45 6789. Numbers the SSA never issues are rejected:
+// area numbers 000, 666 and 900-999, group number 00 and serial number 0000.
 func SSN(str string) bool {
-	if str == "" || len(str) != 11 {
+	if len(str) != 11 {
 		return false
 	}
-	return rxSSN.MatchString(str)
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if i == 3 || i == 6 {
+			if c != '-' && c != ' ' {
+				return false
+			}
+		} else if c < '0' || c > '9' {
+			return false
+		}
+	}
+	area, group, serial := str[:3], str[4:6], str[7:]
+	return area != "000" && area != "666" && area[0] != '9' && group != "00" && serial != "0000"
 }
 
 // Semver check if string is valid semantic version
 func Semver(str string) bool {
-	return rxSemver.MatchString(str)
+	_, err := ParseVersion(str)
+	return err == nil
 }
 
 // StringLength check string's length (including multi byte strings)
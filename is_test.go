@@ -538,6 +538,7 @@ func TestEmail(t *testing.T) {
 		{`foo@bar.com.au`, true},
 		{`foo+bar@bar.com`, true},
 		{`foo@bar.coffee`, true},
+		{`foo@a_b.com`, false},
 		{`foo@bar.中文网`, true},
 		{`invalidemail@`, false},
 		{`invalid.com`, false},
@@ -547,7 +548,29 @@ func TestEmail(t *testing.T) {
 		{`hans.m端ller@test.com`, true},
 		{`NathAn.daVIeS@DomaIn.cOM`, true},
 		{`NATHAN.DAVIES@DOMAIN.CO.UK`, true},
-		{`very.(),:;<>[]".VERY."very@\ "very".unusual@strange.example.com`, true},
+		{`"very.(),:;<>[]\".VERY.\"very@\\ \"very\".unusual"@strange.example.com`, true},
+		{`very.(),:;<>[]".VERY."very@\ "very".unusual@strange.example.com`, false},
+		{`a@@b`, false},
+		{`a b@c.com`, false},
+		{`@@`, false},
+		{`.foo@bar.com`, false},
+		{`foo.@bar.com`, false},
+		{`foo..bar@bar.com`, false},
+		{`"foo bar"@bar.com`, true},
+		{`"foo"bar@bar.com`, false},
+		{`"foo@bar.com`, false},
+		{`foo@[192.0.2.1]`, true},
+		{`foo@[IPv6:2001:db8::1]`, true},
+		{`foo@[IPv6:192.0.2.1]`, false},
+		{`foo@[300.0.0.1]`, false},
+		{`foo@localhost`, true},
+		{`foo@bar..com`, false},
+		{`foo@-bar.com`, false},
+		{`foo@bar.com.`, false},
+		{strings.Repeat("a", 64) + `@bar.com`, true},
+		{strings.Repeat("a", 65) + `@bar.com`, false},
+		{strings.Repeat("a", 64) + "@" + strings.Repeat("b", 62) + "." + strings.Repeat("c", 62) + "." + strings.Repeat("d", 61), true},
+		{strings.Repeat("a", 64) + "@" + strings.Repeat("b", 63) + "." + strings.Repeat("c", 63) + "." + strings.Repeat("d", 63), false},
 	}
 	for _, test := range tests {
 		actual := Email(test.param)
@@ -560,7 +583,7 @@ func TestEmail(t *testing.T) {
 func ExampleEmail() {
 	fmt.Println(Email("jhon@example.com"))
 	fmt.Println(Email("invalid.com"))
-	fmt.Println(Email(`very.(),:;<>[]".VERY."very@\ "very".unusual@strange.example.com`))
+	fmt.Println(Email(`"very.(),:;<>[]\".VERY.\"very@\\ \"very\".unusual"@strange.example.com`))
 	// Output:
 	// true
 	// false
@@ -1083,7 +1106,8 @@ func TestUUID(t *testing.T) {
 		{"412452646", false},
 		{"xxxa987fbc9-4bed-3078-cf07-9141ba07c9f3", false},
 		{"a987fbc9-4bed-4078-8f07-9141ba07c9f3", false},
-		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", true},
+		{"a987fbc9-4bed-3078-cf07-9141ba07c9f3", false},
+		{"a987fbc9-4bed-3078-8f07-9141ba07c9f3", true},
 	}
 	for _, test := range tests {
 		actual := UUIDv3(test.param)
@@ -1441,6 +1465,7 @@ func TestDNSName(t *testing.T) {
 		{"localhost", true},
 		{"localhost.local", true},
 		{"localhost.localdomain.intern", true},
+		{"a.b", true},
 		{"-localhost", false},
 		{"localhost.-localdomain", false},
 		{"localhost.localdomain.-int", false},
@@ -1597,6 +1622,17 @@ func TestSSN(t *testing.T) {
 		{"66690-76", false},
 		{"191 60 2869", true},
 		{"191-60-2869", true},
+		{"191-60 2869", true},
+		{"191602869", false},
+		{"191_60_2869", false},
+		{"000-60-2869", false},
+		{"666-60-2869", false},
+		{"900-60-2869", false},
+		{"999-60-2869", false},
+		{"899-60-2869", true},
+		{"191-00-2869", false},
+		{"191-60-0000", false},
+		{"19a-60-2869", false},
 	}
 	for _, test := range tests {
 		actual := SSN(test.param)
@@ -1654,6 +1690,8 @@ func TestSemver(t *testing.T) {
 		{"1.0.0-+beta", false},
 		{"1.0.0-b+-9+eta", false},
 		{"v+1.8.0-b+-9+eta", false},
+		{"18446744073709551615.0.0", true},
+		{"18446744073709551616.0.0", false},
 	}
 	for _, test := range tests {
 		actual := Semver(test.param)
@@ -1680,7 +1718,7 @@ func TestWhole(t *testing.T) {
 	for _, test := range tests {
 		actual := Whole(test.param)
 		if actual != test.expected {
-			t.Errorf("Expected Whole(%q) to be %v, got %v", test.param, test.expected, actual)
+			t.Errorf("Expected Whole(%v) to be %v, got %v", test.param, test.expected, actual)
 		}
 	}
 }
@@ -1702,7 +1740,7 @@ func TestNatural(t *testing.T) {
 	for _, test := range tests {
 		actual := Natural(test.param)
 		if actual != test.expected {
-			t.Errorf("Expected Natural(%q) to be %v, got %v", test.param, test.expected, actual)
+			t.Errorf("Expected Natural(%v) to be %v, got %v", test.param, test.expected, actual)
 		}
 	}
 }
@@ -1728,7 +1766,7 @@ func TestInRange(t *testing.T) {
 	for _, test := range tests {
 		actual := InRange(test.param, test.left, test.right)
 		if actual != test.expected {
-			t.Errorf("Expected InRange(%q, %q, %q) to be %v, got %v", test.param, test.left, test.right, test.expected, actual)
+			t.Errorf("Expected InRange(%v, %v, %v) to be %v, got %v", test.param, test.left, test.right, test.expected, actual)
 		}
 	}
 }
@@ -1758,3 +1796,119 @@ func TestStringLength(t *testing.T) {
 		}
 	}
 }
+
+func TestCheckFunctions(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name   string
+		check  func(string) error
+		param  string
+		code   string
+		offset int
+	}{
+		{"CheckEmail", CheckEmail, "foo@bar.com", "", 0},
+		{"CheckEmail", CheckEmail, "", CodeEmpty, -1},
+		{"CheckEmail", CheckEmail, "invalid.com", CodeBadFormat, -1},
+		{"CheckEmail", CheckEmail, "@invalid.com", CodeBadFormat, 0},
+		{"CheckEmail", CheckEmail, "invalidemail@", CodeBadFormat, 12},
+		{"CheckURL", CheckURL, "http://foobar.com", "", 0},
+		{"CheckURL", CheckURL, "", CodeEmpty, -1},
+		{"CheckURL", CheckURL, "a.b", CodeTooShort, -1},
+		{"CheckURL", CheckURL, "http://" + strings.Repeat("a", 2080), CodeTooLong, -1},
+		{"CheckURL", CheckURL, ".com", CodeInvalidChar, 0},
+		{"CheckURL", CheckURL, "http://.foo.com", CodeInvalidChar, 7},
+		{"CheckURL", CheckURL, "xyz://foobar.com", CodeBadFormat, -1},
+		{"CheckCreditCard", CheckCreditCard, "4716-2210-5188-5662", "", 0},
+		{"CheckCreditCard", CheckCreditCard, "", CodeEmpty, -1},
+		{"CheckCreditCard", CheckCreditCard, "foo", CodeTooShort, -1},
+		{"CheckCreditCard", CheckCreditCard, "41111111111111111111", CodeTooLong, -1},
+		{"CheckCreditCard", CheckCreditCard, "9111111111111111", CodeBadFormat, -1},
+		{"CheckCreditCard", CheckCreditCard, "5398 2287 0787 1528", CodeBadChecksum, 18},
+		{"CheckDNSName", CheckDNSName, "localhost.localdomain", "", 0},
+		{"CheckDNSName", CheckDNSName, "a.b", "", 0},
+		{"CheckDNSName", CheckDNSName, "", CodeEmpty, -1},
+		{"CheckDNSName", CheckDNSName, "localhost..local", CodeBadFormat, 10},
+		{"CheckDNSName", CheckDNSName, "localhost.", CodeBadFormat, 10},
+		{"CheckDNSName", CheckDNSName, "foo." + strings.Repeat("a", 64), CodeTooLong, 4},
+		{"CheckDNSName", CheckDNSName, strings.Repeat("ab.", 128) + "a", CodeTooLong, -1},
+		{"CheckDNSName", CheckDNSName, "foo.-bar", CodeInvalidChar, 4},
+		{"CheckDNSName", CheckDNSName, "foo-.bar", CodeInvalidChar, 3},
+		{"CheckDNSName", CheckDNSName, "lÖcalhost", CodeInvalidChar, 1},
+		{"CheckDNSName", CheckDNSName, "local*host", CodeInvalidChar, 5},
+	}
+	for _, test := range tests {
+		err := test.check(test.param)
+		if test.code == "" {
+			if err != nil {
+				t.Errorf("Expected %s(%q) to succeed, got %v", test.name, test.param, err)
+			}
+			continue
+		}
+		actual, ok := err.(*ValidationError)
+		if !ok {
+			t.Errorf("Expected %s(%q) to return a *ValidationError, got %v", test.name, test.param, err)
+			continue
+		}
+		if actual.Code != test.code || actual.Offset != test.offset {
+			t.Errorf("Expected %s(%q) to fail with %s at %d, got %s at %d", test.name, test.param, test.code, test.offset, actual.Code, actual.Offset)
+		}
+	}
+}
+
+func TestCheckISBN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param   string
+		version int
+		code    string
+		offset  int
+	}{
+		{"3 401 01319 X", 10, "", 0},
+		{"978-4-87311-368-5", 13, "", 0},
+		{"978-4-87311-368-5", -1, "", 0},
+		{"", 10, CodeEmpty, -1},
+		{" - ", -1, CodeEmpty, -1},
+		{"3-423-21412", 10, CodeTooShort, -1},
+		{"3-423-21412-0-1", 10, CodeTooLong, 14},
+		{"3-423-21412-1", 10, CodeBadChecksum, 12},
+		{"3-42X-21412-1", 10, CodeInvalidChar, 4},
+		{"01234567890ab", 13, CodeInvalidChar, 11},
+		{"978 3 8362 2119 0", 13, CodeBadChecksum, 16},
+		{"123456789", -1, CodeTooShort, -1},
+		{"123456789012", -1, CodeBadLength, -1},
+		{"12345678901234", -1, CodeTooLong, -1},
+	}
+	for _, test := range tests {
+		err := CheckISBN(test.param, test.version)
+		if test.code == "" {
+			if err != nil {
+				t.Errorf("Expected CheckISBN(%q, %d) to succeed, got %v", test.param, test.version, err)
+			}
+			continue
+		}
+		actual, ok := err.(*ValidationError)
+		if !ok || actual.Code != test.code || actual.Offset != test.offset {
+			t.Errorf("Expected CheckISBN(%q, %d) to fail with %s at %d, got %v", test.param, test.version, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestValidationError(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    *ValidationError
+		expected string
+	}{
+		{&ValidationError{"ISBN", CodeBadChecksum, 12}, "is: ISBN: bad_checksum at offset 12"},
+		{&ValidationError{"URL", CodeTooLong, -1}, "is: URL: too_long"},
+	}
+	for _, test := range tests {
+		actual := test.param.Error()
+		if actual != test.expected {
+			t.Errorf("Expected %#v.Error() to be %q, got %q", test.param, test.expected, actual)
+		}
+	}
+}
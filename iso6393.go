@@ -0,0 +1,336 @@
+package is
+
+// iso6393Codes lists the ISO 639-3 language codes in sorted order, three
+// letters each, from the code tables of the registration authority
+// https://iso639-3.sil.org/code_tables/download_tables
+const iso6393Codes = "" +
+	"aaaaabaacaadaaeaafaagaahaaiaakaalaanaaoaapaaqaaraasaataauaawaaxaazabaabb" +
+	"abcabdabeabfabgabhabiabjabkablabmabnaboabpabqabrabsabtabuabvabwabxabyabz" +
+	"acaacbacdaceacfachaciackaclacmacnacpacqacracsactacuacvacwacxacyaczadaadb" +
+	"addadeadfadgadhadiadjadladnadoadqadradsadtaduadwadxadyadzaeaaebaecaedaee" +
+	"aekaelaemaenaeqaeraesaeuaewaeyaezafbafdafeafgafhafiafkafnafoafpafrafsaft" +
+	"afuafzagaagbagcagdageagfaggaghagiagjagkaglagmagnagoagqagragsagtaguagvagw" +
+	"agxagyagzahaahbahgahhahiahkahlahmahnahoahpahrahsahtaiaaibaicaidaieaifaig" +
+	"aihaiiaijaikailaimainaioaipaiqairaitaiwaixaiyajaajgajiajnajpajsajuajwajz" +
+	"akaakbakcakdakeakfakgakhakiakjakkaklakmakoakpakqakraksaktakuakvakwakxaky" +
+	"akzalaalcaldalealfalhalialjalkallalmalnaloalpalqalralsaltalualwalxalyalz" +
+	"amaambamcameamfamgamhamiamjamkamlammamnamoampamqamramsamtamuamvamwamxamy" +
+	"amzanaanbancandaneanfanganhanianjankanlanmannanoanpanqanransantanuanvanw" +
+	"anxanyanzaoaaobaocaodaoeaofaogaoiaojaokaolaomaonaoraosaotaouaoxaozapbapc" +
+	"apdapeapfapgaphapiapjapkaplapmapnapoappapqaprapsaptapuapvapwapxapyapzaqc" +
+	"aqdaqgaqkaqmaqnaqpaqraqtaqzaraarbarcardareargarhariarjarkarlarnaroarparq" +
+	"arrarsaruarvarwarxaryarzasaasbascaseasfasgashasiasjaskaslasmasnasoaspasq" +
+	"asrassastasuasvaswasxasyaszataatbatcatdateatgatiatjatkatlatmatnatoatpatq" +
+	"atratsattatuatvatwatxatyatzauaaubaucaudaugauhauiaujaukaulaumaunauoaupauq" +
+	"aurautauuauwauxauyauzavaavbavdaveaviavkavlavmavnavoavsavtavuavvawaawbawc" +
+	"aweawgawhawiawkawmawnawoawrawsawtawuawvawwawxawyaxbaxeaxgaxkaxlaxmaxxaya" +
+	"aybaycaydayeaygayhayiaykaylaymaynayoaypayqayraysaytayuayzazaazbazdazeazg" +
+	"azjazmaznazoaztazzbaababbacbaebafbagbahbajbakbalbambanbaobapbarbasbaubav" +
+	"bawbaxbaybbabbbbbcbbdbbebbfbbgbbhbbibbjbbkbblbbmbbnbbobbpbbqbbrbbsbbtbbu" +
+	"bbvbbwbbxbbybcabcbbccbcdbcebcfbcgbchbcibcjbckbclbcmbcnbcobcpbcqbcrbcsbct" +
+	"bcubcvbcwbcybczbdabdbbdcbddbdebdfbdgbdhbdibdjbdkbdlbdmbdnbdobdpbdqbdrbds" +
+	"bdtbdubdvbdwbdxbdybdzbeabebbecbedbeebefbegbehbeibejbekbelbembenbeobepbeq" +
+	"besbetbeubevbewbexbeybezbfabfbbfcbfdbfebffbfgbfhbfibfjbfkbflbfmbfnbfobfp" +
+	"bfqbfrbfsbftbfubfwbfxbfybfzbgabgbbgcbgdbgebgfbggbgibgjbgkbglbgnbgobgpbgq" +
+	"bgrbgsbgtbgubgvbgwbgxbgybgzbhabhbbhcbhdbhebhfbhgbhhbhibhjbhlbhmbhnbhobhp" +
+	"bhqbhrbhsbhtbhubhvbhwbhxbhybhzbiabibbidbiebifbigbikbilbimbinbiobipbiqbir" +
+	"bisbitbiubivbiwbixbiybizbjabjbbjcbjebjfbjgbjhbjibjjbjkbjlbjmbjnbjobjpbjr" +
+	"bjsbjtbjubjvbjwbjxbjybjzbkabkcbkdbkfbkgbkhbkibkjbkkbklbkmbknbkobkpbkqbkr" +
+	"bksbktbkubkvbkwbkxbkybkzblablbblcbldbleblfblhblibljblkbllblmblnbloblpblq" +
+	"blrblsbltblvblwblxblyblzbmabmbbmcbmdbmebmfbmgbmhbmibmjbmkbmlbmmbmnbmobmp" +
+	"bmqbmrbmsbmtbmubmvbmwbmxbmzbnabnbbncbndbnebnfbngbnibnjbnkbnlbnmbnnbnobnp" +
+	"bnqbnrbnsbnubnvbnwbnxbnybnzboabobbodboebofbogbohboibojbokbolbombonboobop" +
+	"boqborbosbotboubovbowboxboybozbpabpcbpdbpebpgbphbpibpjbpkbplbpmbpnbpobpp" +
+	"bpqbprbpsbptbpubpvbpwbpxbpybpzbqabqbbqcbqdbqfbqgbqhbqibqjbqkbqlbqmbqnbqo" +
+	"bqpbqqbqrbqsbqtbqubqvbqwbqxbqybqzbrabrbbrcbrdbrebrfbrgbrhbribrjbrkbrlbrm" +
+	"brnbrobrpbrqbrrbrsbrtbrubrvbrwbrxbrybrzbsabsbbscbsebsfbsgbshbsibsjbskbsl" +
+	"bsmbsnbsobspbsqbsrbssbstbsubsvbswbsxbsybtabtcbtdbtebtfbtgbthbtibtjbtmbtn" +
+	"btobtpbtqbtrbtsbttbtubtvbtwbtxbtybtzbuabubbucbudbuebufbugbuhbuibujbukbul" +
+	"bumbunbuobupbuqbusbutbuubuvbuwbuxbuybuzbvabvbbvcbvdbvebvfbvgbvhbvibvjbvk" +
+	"bvlbvmbvnbvobvpbvqbvrbvtbvubvvbvwbvxbvybvzbwabwbbwcbwdbwebwfbwgbwhbwibwj" +
+	"bwkbwlbwmbwnbwobwpbwqbwrbwsbwtbwubwwbwxbwybwzbxabxbbxcbxdbxebxfbxgbxhbxi" +
+	"bxjbxkbxlbxmbxnbxobxpbxqbxrbxsbxubxvbxwbxzbyabybbycbydbyebyfbygbyhbyibyj" +
+	"bykbylbymbynbyobypbyqbyrbysbytbyvbywbyxbyzbzabzbbzcbzdbzebzfbzgbzhbzibzj" +
+	"bzkbzlbzmbznbzobzpbzqbzrbzsbztbzubzvbzwbzxbzybzzcaacabcaccadcaecafcagcah" +
+	"cajcakcalcamcancaocapcaqcarcascatcavcawcaxcaycazcbbcbccbdcbgcbicbjcbkcbl" +
+	"cbncbocbqcbrcbscbtcbucbvcbwcbycccccdcceccgcchccjcclccmccoccpccrcdacdecdf" +
+	"cdhcdicdjcdmcdncdocdrcdscdycdzceacebcegcekcencescetceycfacfdcfgcfmcgacgc" +
+	"cggcgkchachbchcchdchechfchgchhchjchkchlchmchnchochpchqchrchtchuchvchwchx" +
+	"chychzciacibciccidciecihcikcimcincipcirciwciycjacjecjhcjicjkcjmcjncjocjp" +
+	"cjscjvcjyckbckhcklckmcknckockqckrckscktckuckvckxckyckzclaclccldcleclhcli" +
+	"cljclkcllclmclocltcluclwclycmacmecmgcmicmlcmmcmncmocmrcmscmtcnacnbcnccng" +
+	"cnhcnicnkcnlcnocnpcnqcnrcnscntcnucnwcnxcoacobcoccodcoecofcogcohcojcokcol" +
+	"comconcoocopcoqcorcoscotcoucovcowcoxcozcpacpbcpccpgcpicpncpocpscpucpxcpy" +
+	"cqdcracrbcrccrdcrecrfcrgcrhcricrjcrkcrlcrmcrncrocrqcrrcrscrtcrvcrwcrxcry" +
+	"crzcsacsbcsccsdcsecsfcsgcshcsicsjcskcslcsmcsncsocspcsqcsrcsscstcsvcswcsx" +
+	"csycszctactcctdctectgcthctlctmctnctoctpctscttctuctyctzcuacubcuccuhcuicuj" +
+	"cukculcuocupcuqcurcutcuucuvcuwcuxcuycvgcvncwacwbcwdcwecwgcwtcyacybcymcyo" +
+	"czhczkcznczocztdaadacdaddaedagdahdaidajdakdaldamdandaodaqdardasdaudavdaw" +
+	"daxdazdbadbbdbddbedbfdbgdbidbjdbldbmdbndbodbpdbqdbrdbtdbudbvdbwdbydccdcr" +
+	"ddadddddeddgddiddjddnddoddrddsddwdecdeddeedefdegdehdeidekdeldemdendepdeq" +
+	"derdesdeudevdezdgadgbdgcdgddgedggdghdgidgkdgldgndgodgrdgsdgtdgwdgxdgzdhd" +
+	"dhgdhidhldhmdhndhodhrdhsdhudhvdhwdhxdiadibdicdiddifdigdihdiidijdikdildim" +
+	"dindiodipdiqdirdisdiudivdiwdixdiydizdjadjbdjcdjddjedjfdjidjjdjkdjmdjndjo" +
+	"djrdjudjwdkadkgdkkdkrdksdkxdlgdlkdlmdlndmadmbdmcdmddmedmfdmgdmkdmldmmdmo" +
+	"dmrdmsdmudmvdmwdmxdmydnadnddnedngdnidnjdnkdnndnodnrdntdnudnvdnwdnydoadob" +
+	"docdoedofdohdoidokdoldondoodopdoqdordosdotdovdowdoxdoydozdppdrbdrcdrddre" +
+	"drgdridrldrndrodrqdrsdrtdrudrydsbdsedshdsidsldsndsodsqdszdtadtbdtddthdti" +
+	"dtkdtmdtndtodtpdtrdtsdttdtudtyduadubducduedufdugduhduidukduldumdunduodup" +
+	"duqdurdusduuduvduwduxduyduzdvadwadwkdwrdwsdwudwwdwydwzdyadybdyddygdyidym" +
+	"dyndyodyudyydzadzedzgdzldzndzoeaaebcebgebkeboebrebuecrecsecyeeeefaefeefi" +
+	"egaeglegmegoegyehsehueipeiteivejaekaekeekgekiekkeklekmekoekpekrekyeleelh" +
+	"elielkellelmeloeluelxemaembemeemgemiemkemmemnempemqemsemuemwemxemyemzena" +
+	"enbencendenfengenhenlenmennenoenqenrenuenvenwenxeotepiepoeraergerherierk" +
+	"eroerrerserterweseesgeshesieskeslesmesnesoesqessestesuesyetbetcethetneto" +
+	"etretsettetuetxetzeuseveevhevneweewoexteyaeyoezaezefaafabfadfaffagfahfai" +
+	"fajfakfalfamfanfaofapfarfasfatfaufaxfayfazfblfcsferffiffmfgrfiafiefiffij" +
+	"filfinfipfirfitfiwfkkfkvflaflhflifllflnflrflyfmpfmufnbfngfnifodfoifomfon" +
+	"forfosfpefqsfrafrcfrdfrkfrmfrofrpfrqfrrfrsfrtfryfsefslfssfubfucfudfuefuf" +
+	"fuhfuifujfulfumfunfuqfurfutfuufuvfuyfvrfwafwegaagabgacgadgaegafgaggahgai" +
+	"gajgakgalgamgangaogapgaqgargasgatgaugawgaxgaygazgbagbbgbdgbegbfgbggbhgbi" +
+	"gbjgbkgblgbmgbngbogbpgbqgbrgbsgbugbvgbwgbxgbygbzgccgcdgcegcfgclgcngcrgct" +
+	"gdagdbgdcgddgdegdfgdggdhgdigdjgdkgdlgdmgdngdogdqgdrgdsgdtgdugdxgeagebgec" +
+	"gedgefgeggehgeigejgekgelgeqgesgevgewgexgeygezgfkgftggaggbggdggegggggkggl" +
+	"ggtgguggwghaghcgheghhghkghlghnghoghrghsghtgiagibgicgidgiegiggihgiigilgim" +
+	"gingipgiqgirgisgitgiugiwgixgiygizgjkgjmgjngjrgjugkagkdgkegkngkogkpgkugla" +
+	"glbglcgldgleglgglhgljglkgllgloglrgluglvglwglygmagmbgmdgmggmhgmlgmmgmngmr" +
+	"gmugmvgmxgmygmzgnagnbgncgndgnegnggnhgnignjgnkgnlgnmgnngnognqgnrgntgnugnw" +
+	"gnzgoagobgocgodgoegofgoggohgoigojgokgolgomgongoogopgoqgorgosgotgougovgow" +
+	"goxgoygozgpagpegpngqagqigqngqrgqugragrbgrcgrdgrggrhgrigrjgrmgrngrogrqgrr" +
+	"grsgrtgrugrvgrwgrxgrygrzgsegsggslgsmgsngsogspgssgswgtagtuguagubgucgudgue" +
+	"gufgugguhguigujgukgulgumgunguogupguqgurgusgutguuguwguxguzgvagvcgvegvfgvj" +
+	"gvlgvmgvngvogvpgvrgvsgvygwagwbgwcgwdgwegwfgwggwigwjgwmgwngwrgwtgwugwwgwx" +
+	"gxxgyagybgydgyegyfgyggyigylgymgyngyogyrgyygyzgzagzigznhaahabhachadhaehaf" +
+	"haghahhaihajhakhalhamhanhaohaphaqharhashathauhavhawhaxhayhazhbahbbhbnhbo" +
+	"hbshbuhcahchhdnhdshdyheahebhedheghehheihemherhgmhgwhhihhrhhyhiahibhidhif" +
+	"highihhiihijhikhilhinhiohirhithiwhixhjihkahkehkhhkkhknhkshlahlbhldhlehlt" +
+	"hluhmahmbhmchmdhmehmfhmghmhhmihmjhmkhmlhmmhmnhmohmphmqhmrhmshmthmuhmvhmw" +
+	"hmyhmzhnahndhnehnghnhhnihnjhnnhnohnshnuhoahobhochodhoehohhoihojholhomhoo" +
+	"hophorhoshothovhowhoyhozhpohpshrahrchrehrkhrmhrohrphrthruhrvhrwhrxhrzhsb" +
+	"hshhslhsnhsshtihtohtshtuhtxhubhuchudhuehufhughuhhuihujhukhulhumhunhuohup" +
+	"huqhurhushuthuuhuvhuwhuxhuyhuzhvchvehvkhvnhvvhwahwchwohyahyehywiaiianiar" +
+	"ibaibbibdibeibgibhiblibmibniboibribuibyicaichiclicridaidbidciddideidiido" +
+	"idridsidtiduifaifbifeiffifkifmifuifyigbigeiggigligmignigoigsigwihbihiihp" +
+	"ihwiiiiinijcijeijjijnijsikeikiikkiklikoikpikriksiktikuikvikwikxikzilailb" +
+	"ileilgiliilkilmiloilpilsiluilvimaimiimlimnimoimrimsimtimyinainbindinginh" +
+	"injinlinminninoinpinsintinzioriouiowipiipkipoiquiqwireirhiriirkirnirriru" +
+	"irxiryisaiscisdiseisgishisiiskislismisnisoisristisuitaitbitditeitiitkitl" +
+	"itmitoitritsittitvitwitxityitziumivbivviwkiwmiwoiwsixcixliyaiyoiyxizhizr" +
+	"izzjaajabjacjadjaejafjahjajjakjaljamjanjaojaqjasjatjaujavjaxjayjazjbejbi" +
+	"jbjjbkjbmjbnjbojbrjbtjbujbwjcsjctjdajdgjdtjebjeejehjeijekjeljenjerjetjeu" +
+	"jgbjgejgkjgojhijhsjiajibjicjidjiejigjihjiijiljimjiojiqjitjiujivjiyjjejjr" +
+	"jkajkmjkojkpjkrjksjkujlejlsjmajmbjmcjmdjmijmljmnjmrjmsjmwjmxjnajndjngjni" +
+	"jnjjnljnsjobjodjogjorjosjowjpajpnjprjqrjrajrbjrrjrtjrujsljuajubjucjudjuh" +
+	"juijukjuljumjunjuojupjurjusjutjuujuwjuyjvdjvnjwijyajyejyykaakabkackadkae" +
+	"kafkagkahkaikajkakkalkamkankaokapkaqkaskatkaukavkawkaxkaykazkbakbbkbckbd" +
+	"kbekbgkbhkbikbjkbkkblkbmkbnkbokbpkbqkbrkbskbtkbukbvkbwkbxkbykbzkcakcbkcc" +
+	"kcdkcekcfkcgkchkcikcjkckkclkcmkcnkcokcpkcqkcrkcskctkcukcvkcwkcxkcykczkda" +
+	"kdckddkdekdfkdgkdhkdikdjkdkkdlkdmkdnkdpkdqkdrkdtkdukdwkdxkdykdzkeakebkec" +
+	"kedkeekefkegkehkeikejkekkelkemkenkeokepkeqkerkesketkeukevkewkexkeykezkfa" +
+	"kfbkfckfdkfekffkfgkfhkfikfjkfkkflkfmkfnkfokfpkfqkfrkfskftkfukfvkfwkfxkfy" +
+	"kfzkgakgbkgekgfkggkgikgjkgkkglkgmkgnkgokgpkgqkgrkgskgtkgukgvkgwkgxkgykha" +
+	"khbkhckhdkhekhfkhgkhhkhjkhkkhlkhmkhnkhokhpkhqkhrkhskhtkhukhvkhwkhxkhykhz" +
+	"kiakibkickidkiekifkigkihkiikijkikkilkimkinkiokipkiqkirkiskitkiukivkiwkix" +
+	"kiykizkjakjbkjckjdkjekjgkjhkjikjjkjkkjlkjmkjnkjokjpkjqkjrkjskjtkjukjvkjx" +
+	"kjykjzkkakkbkkckkdkkekkfkkgkkhkkikkjkkkkklkkmkknkkokkpkkqkkrkkskktkkukkv" +
+	"kkwkkxkkykkzklaklbklckldkleklfklgklhklikljklkkllklmklnkloklpklqklrklsklt" +
+	"kluklvklwklxklyklzkmakmbkmckmdkmekmfkmgkmhkmikmjkmkkmlkmmkmnkmokmpkmqkmr" +
+	"kmskmtkmukmvkmwkmxkmykmzknaknbknckndkneknfkngkniknjknkknlknmknnknoknpknq" +
+	"knrknskntknuknvknwknxknyknzkoakockodkoekofkogkohkoikokkolkomkonkookopkoq" +
+	"korkoskotkoukovkowkoykozkpakpbkpckpdkpekpfkpgkphkpikpjkpkkplkpmkpnkpokpq" +
+	"kprkpskptkpukpvkpwkpxkpykpzkqakqbkqckqdkqekqfkqgkqhkqikqjkqkkqlkqmkqnkqo" +
+	"kqpkqqkqrkqskqtkqukqvkqwkqxkqykqzkrakrbkrckrdkrekrfkrhkrikrjkrkkrlkrnkrp" +
+	"krrkrskrtkrukrvkrwkrxkrykrzksaksbkscksdkseksfksgkshksiksjkskkslksmksnkso" +
+	"kspksqksrksskstksuksvkswksxksykszktaktbktcktdktektfktgkthktiktjktkktlktm" +
+	"ktnktoktpktqktskttktuktvktwktxktyktzkuakubkuckudkuekufkugkuhkuikujkukkul" +
+	"kumkunkuokupkuqkurkuskutkuukuvkuwkuxkuykuzkvakvbkvckvdkvekvfkvgkvhkvikvj" +
+	"kvkkvlkvmkvnkvokvpkvqkvrkvtkvukvvkvwkvxkvykvzkwakwbkwckwdkwekwfkwgkwhkwi" +
+	"kwjkwkkwlkwmkwnkwokwpkwrkwskwtkwukwvkwwkwxkwykwzkxakxbkxckxdkxfkxhkxikxj" +
+	"kxkkxmkxnkxokxpkxqkxrkxskxtkxvkxwkxxkxykxzkyakybkyckydkyekyfkygkyhkyikyj" +
+	"kykkylkymkynkyokypkyqkyrkyskytkyukyvkywkyxkyykyzkzakzbkzckzdkzekzfkzgkzi" +
+	"kzkkzlkzmkznkzokzpkzqkzrkzskzukzvkzwkzxkzykzzlaalablacladlaelaflaglahlai" +
+	"lajlallamlanlaolaplaqlarlaslatlaulavlawlaxlaylazlbblbclbelbflbglbilbjlbk" +
+	"lbllbmlbnlbolbqlbrlbslbtlbulbvlbwlbxlbylbzlcclcdlcelcflchlcllcmlcplcqlcs" +
+	"ldaldblddldgldhldildjldkldlldmldnldoldpldqlealeblecledleeleflehleilejlek" +
+	"lellemlenleolepleqlerlesletleulevlewlexleylezlfalfnlgalgblgglghlgilgklgl" +
+	"lgmlgnlgolgqlgrlgtlgulgzlhalhhlhilhllhmlhnlhplhslhtlhulialibliclidlielif" +
+	"liglihlijliklillimlinliolipliqlirlislitliulivliwlixliylizljaljeljiljlljp" +
+	"ljwljxlkalkblkclkdlkelkhlkilkjlkllkmlknlkolkrlkslktlkulkyllallbllclldlle" +
+	"llfllgllhllilljllklllllmllnllpllqllsllullxlmalmblmclmdlmelmflmglmhlmilmj" +
+	"lmklmllmnlmolmplmqlmrlmulmvlmwlmxlmylnalnblndlnglnhlnilnjlnllnmlnnlnslnu" +
+	"lnwlnzloaloblocloelofloglohloilojloklollomlonlooloploqlorloslotloulovlow" +
+	"loxloylozlpalpelpnlpolpxlqrlralrclrelrglrilrklrllrmlrnlrolrrlrtlrvlrzlsa" +
+	"lsblsclsdlselshlsilsllsmlsnlsolsplsrlsslstlsvlswlsyltcltglthltiltnltolts" +
+	"ltultzlualublucludlueluflugluilujluklullumlunluolupluqlurluslutluuluvluw" +
+	"luyluzlvalvilvklvslvulwalwelwglwhlwllwmlwolwslwtlwulwwlxmlyalyglynlzhlzl" +
+	"lznlzzmaamabmadmaemafmagmahmaimajmakmalmammanmaqmarmasmatmaumavmawmaxmaz" +
+	"mbambbmbcmbdmbembfmbhmbimbjmbkmblmbmmbnmbombpmbqmbrmbsmbtmbumbvmbwmbxmby" +
+	"mbzmcamcbmccmcdmcemcfmcgmchmcimcjmckmclmcmmcnmcomcpmcqmcrmcsmctmcumcvmcw" +
+	"mcxmcymczmdamdbmdcmddmdemdfmdgmdhmdimdjmdkmdlmdmmdnmdpmdqmdrmdsmdtmdumdv" +
+	"mdwmdxmdymdzmeamebmecmedmeemefmehmeimejmekmelmemmenmeomepmeqmermesmetmeu" +
+	"mevmewmeymezmfamfbmfcmfdmfemffmfgmfhmfimfjmfkmflmfmmfnmfomfpmfqmfrmfsmft" +
+	"mfumfvmfwmfxmfymfzmgamgbmgcmgdmgemgfmggmghmgimgjmgkmglmgmmgnmgomgpmgqmgr" +
+	"mgsmgtmgumgvmgwmgymgzmhamhbmhcmhdmhemhfmhgmhimhjmhkmhlmhmmhnmhomhpmhqmhr" +
+	"mhsmhtmhumhwmhxmhymhzmiamibmicmidmiemifmigmihmiimijmikmilmimminmiomipmiq" +
+	"mirmismitmiumiwmixmiymizmjbmjcmjdmjemjgmjhmjimjjmjkmjlmjmmjnmjomjpmjqmjr" +
+	"mjsmjtmjumjvmjwmjxmjymjzmkamkbmkcmkdmkemkfmkgmkimkjmkkmklmkmmknmkomkpmkq" +
+	"mkrmksmktmkumkvmkwmkxmkymkzmlamlbmlcmlemlfmlgmlhmlimljmlkmllmlmmlnmlomlp" +
+	"mlqmlrmlsmltmlumlvmlwmlxmlzmmammbmmcmmdmmemmfmmgmmhmmimmjmmkmmlmmmmmnmmo" +
+	"mmpmmqmmrmmtmmummvmmwmmxmmymmzmnamnbmncmndmnemnfmngmnhmnimnjmnkmnlmnmmnn" +
+	"mnpmnqmnrmnsmnumnvmnwmnxmnymnzmoamocmodmoemogmohmoimojmokmommonmoomopmoq" +
+	"mormosmotmoumovmowmoxmoymozmpampbmpcmpdmpempgmphmpimpjmpkmplmpmmpnmpompp" +
+	"mpqmprmpsmptmpumpvmpwmpxmpympzmqamqbmqcmqemqfmqgmqhmqimqjmqkmqlmqmmqnmqo" +
+	"mqpmqqmqrmqsmqtmqumqvmqwmqxmqymqzmramrbmrcmrdmremrfmrgmrhmrimrjmrkmrlmrm" +
+	"mrnmromrpmrqmrrmrsmrtmrumrvmrwmrxmrymrzmsamsbmscmsdmsemsfmsgmshmsimsjmsk" +
+	"mslmsmmsnmsomspmsqmsrmssmsumsvmswmsxmsymszmtamtbmtcmtdmtemtfmtgmthmtimtj" +
+	"mtkmtlmtmmtnmtomtpmtqmtrmtsmttmtumtvmtwmtxmtymuamubmucmudmuemugmuhmuimuj" +
+	"mukmulmummuomupmuqmurmusmutmuumuvmuxmuymuzmvamvbmvdmvemvfmvgmvhmvimvkmvl" +
+	"mvnmvomvpmvqmvrmvsmvtmvumvvmvwmvxmvymvzmwamwbmwcmwemwfmwgmwhmwimwkmwlmwm" +
+	"mwnmwomwpmwqmwrmwsmwtmwumwvmwwmwzmxamxbmxcmxdmxemxfmxgmxhmximxjmxkmxlmxm" +
+	"mxnmxomxpmxqmxrmxsmxtmxumxvmxwmxxmxymxzmyamybmycmyemyfmygmyhmyjmykmylmym" +
+	"myomypmyrmysmyumyvmywmyxmyymyzmzamzbmzcmzdmzemzgmzhmzimzjmzkmzlmzmmznmzo" +
+	"mzpmzqmzrmzsmztmzumzvmzwmzxmzymzznaanabnacnaenafnagnajnaknalnamnannaonap" +
+	"naqnarnasnatnaunavnawnaxnaynaznbanbbnbcnbdnbenbgnbhnbinbjnbknblnbmnbnnbo" +
+	"nbpnbqnbrnbsnbtnbunbvnbwnbyncancbnccncdncencfncgnchncincjncknclncmncnnco" +
+	"ncqncrncsnctncuncxnczndandbndcnddndendfndgndhndindjndkndlndmndnndondpndq" +
+	"ndrndsndtndundvndwndxndyndzneanebnecnedneenefnegnehneinejneknemnenneonep" +
+	"neqnernesnetneunevnewnexneyneznfanfdnflnfrnfungangbngcngdngenggnghngingj" +
+	"ngknglngmngnngpngqngrngsngtngungvngwngxngyngznhanhbnhcnhdnhenhfnhgnhhnhi" +
+	"nhknhmnhnnhonhpnhqnhrnhtnhunhvnhwnhxnhynhznianibnidnienifnignihniinijnik" +
+	"nilnimninnioniqnirnisnitniunivniwnixniyniznjanjbnjdnjhnjinjjnjlnjmnjnnjo" +
+	"njrnjsnjtnjunjxnjynjznkankbnkcnkdnkenkfnkgnkhnkinkjnkknkmnknnkonkpnkqnkr" +
+	"nksnktnkunkvnkwnkxnkznlanlcnldnlenlgnlinljnlknllnlmnlonlqnlunlvnlwnlxnly" +
+	"nlznmanmbnmcnmdnmenmfnmgnmhnminmjnmknmlnmmnmnnmonmpnmqnmrnmsnmtnmunmvnmw" +
+	"nmxnmynmznnannbnncnndnnennfnngnnhnninnjnnknnlnnmnnnnnonnpnnqnnrnntnnunnv" +
+	"nnwnnynnznoanobnocnodnoenofnognohnoinojnoknolnomnonnopnoqnornosnotnounov" +
+	"nownoynoznpanpbnpgnphnpinplnpnnponpsnpunpxnpynqgnqknqlnqmnqnnqonqqnqtnqy" +
+	"nranrbnrcnrenrfnrgnrinrknrlnrmnrnnrpnrrnrtnrunrxnrznsansbnscnsdnsensfnsg" +
+	"nshnsinsknslnsmnsnnsonspnsqnsrnssnstnsunsvnswnsxnsynszntdntentgntintjntk" +
+	"ntmntontpntrntuntwntxntyntznuanucnudnuenufnugnuhnuinujnuknulnumnunnuonup" +
+	"nuqnurnusnutnuunuvnuwnuxnuynuznvhnvmnvonwanwbnwcnwenwgnwinwmnwonwrnwwnwx" +
+	"nwynxanxdnxenxgnxinxknxlnxmnxnnxonxqnxrnxxnyanybnycnydnyenyfnygnyhnyinyj" +
+	"nyknylnymnynnyonypnyqnyrnysnytnyunyvnywnyxnyynzanzbnzdnzinzknzmnzsnzunzy" +
+	"nzzoaaoacoaroavobiobkoblobmoboobrobtobuocaochociocmocoocuodaodkodtoduofo" +
+	"ofsofuogbogcogeoggogooguohtohuoiaoieoinojbojcojgojiojpojsojvojwokaokbokc" +
+	"okdokeokgokhokiokjokkoklokmoknokookroksokuokvokxokzolaoldoleolkolmoloolr" +
+	"oltoluomaombomcomgomiomkomlomnomoompomromtomuomwomxomyonaonboneongonionj" +
+	"onkonnonoonponronsontonuonwonxoodoogoonooroosopaopkopmopooptopyoraorcore" +
+	"orgorhoriormornoroorrorsortoruorvorworxoryorzosaoscosiosnosoospossostosu" +
+	"osxotaotbotdoteotiotkotlotmotnotqotrotsottotuotwotxotyotzouaouboueouioum" +
+	"ovdowiowloyboydoymoyyozmpabpacpadpaepafpagpahpaipakpalpampanpaopappaqpar" +
+	"paspaupavpawpaxpaypazpbbpbcpbepbfpbgpbhpbipblpbmpbnpbopbppbrpbspbtpbupbv" +
+	"pbypcapcbpccpcdpcepcfpcgpchpcipcjpckpclpcmpcnpcppcwpdapdcpdipdnpdopdtpdu" +
+	"peapebpedpeepefpegpehpeipejpekpelpempeopeppeqpespevpexpeypezpfapfepflpga" +
+	"pgdpggpgipgkpglpgnpgspgupgzphaphdphgphhphjphkphlphmphnphophqphrphtphuphv" +
+	"phwpiapibpicpidpiepifpigpihpijpilpimpinpiopippirpispitpiupivpiwpixpiypiz" +
+	"pjtpkapkbpkcpkgpkhpknpkopkppkrpkspktpkuplaplbplcpldpleplgplhplipljplkpll" +
+	"plnploplqplrplspltpluplvplwplyplzpmapmbpmdpmepmfpmhpmipmjpmkpmlpmmpmnpmo" +
+	"pmqpmrpmspmtpmwpmxpmypmzpnapnbpncpndpnepngpnhpnipnjpnkpnlpnmpnnpnopnppnq" +
+	"pnrpnspntpnupnvpnwpnxpnypnzpocpoepofpogpohpoipokpolpomponpoopoppoqporpos" +
+	"potpovpowpoxpoyppeppippkpplppmppnppopppppqppspptppupqapqmprcprdpreprfprg" +
+	"prhpriprkprlprmprnproprpprqprrprsprtpruprwprxprzpsapscpsdpsepsgpshpsipsl" +
+	"psmpsnpsopsppsqpsrpsspstpsupswpsyptapthptiptnptoptpptqptrpttptuptvptwpty" +
+	"puapubpucpudpuepufpugpuipujpumpuopuppuqpurpusputpuupuwpuxpuypwapwbpwgpwi" +
+	"pwmpwnpwopwrpwwpxmpyepympynpyspyupyxpyypzhpznquaqubqucqudquequfqugquhqui" +
+	"qukqulqumqunqupquqqurqusquvquwquxquyquzqvaqvcqveqvhqviqvjqvlqvmqvnqvoqvp" +
+	"qvsqvwqvyqvzqwaqwcqwhqwmqwsqwtqxaqxcqxhqxlqxnqxoqxpqxqqxrqxsqxtqxuqxwqya" +
+	"qypraarabracradrafragrahrairajrakralramranraorapraqrarrasratrauravrawrax" +
+	"rayrazrbbrbkrblrbprcfrdbrearebreeregreirejrelremrenrerresretreyrgargergk" +
+	"rgnrgrrgsrgurhgrhpriaribrifrilrimrinrirritriurjgrjirjsrkarkbrkhrkirkmrkt" +
+	"rkwrmarmbrmcrmdrmermfrmgrmhrmirmkrmlrmmrmnrmormprmqrmsrmtrmurmvrmwrmxrmy" +
+	"rmzrnbrndrngrnlrnnrnprnrrnwrobrocrodroerofrogrohrolromronrooroprorrourow" +
+	"rpnrptrrirrorrtrsbrskrslrsmrsnrtcrthrtmrtsrtwrubrucruerufrugruhruirukrun" +
+	"ruorupruqrusrutruuruyruzrwarwkrwlrwmrworwrrxdrxwrynrysryurzhsaasabsacsad" +
+	"saesafsagsahsajsaksamsansaosaqsarsassatsausavsawsaxsaysazsbasbbsbcsbdsbe" +
+	"sbfsbgsbhsbisbjsbksblsbmsbnsbosbpsbqsbrsbssbtsbusbvsbwsbxsbysbzscbscescf" +
+	"scgschsciscksclscnscoscpscqscssctscuscvscwscxsdasdbsdcsdesdfsdgsdhsdjsdk" +
+	"sdlsdnsdosdpsdqsdrsdssdtsdusdxsdzseasebsecsedseesefsegsehseisejsekselsen" +
+	"seosepseqsersessetseusevsewseysezsfbsfesfmsfssfwsgasgbsgcsgdsgesggsghsgi" +
+	"sgjsgksgmsgpsgrsgssgtsgusgwsgxsgysgzshashbshcshdsheshgshhshishjshkshlshm" +
+	"shnshoshpshqshrshsshtshushvshwshxshyshzsiasibsidsiesifsigsihsiisijsiksil" +
+	"simsinsipsiqsirsissiusivsiwsixsiysizsjasjbsjdsjesjgsjksjlsjmsjnsjosjpsjr" +
+	"sjssjtsjusjwskaskbskcskdskeskfskgskhskiskjskmsknskoskpskqskrskssktskuskv" +
+	"skwskxskyskzslcsldsleslfslgslhslisljslksllslmslnslpslqslrslssltsluslvslw" +
+	"slxslyslzsmasmbsmcsmesmfsmgsmhsmjsmksmlsmmsmnsmosmpsmqsmrsmssmtsmusmvsmw" +
+	"smxsmysmzsnasncsndsnesnfsngsnisnjsnksnlsnmsnnsnosnpsnqsnrsnssnusnvsnwsnx" +
+	"snysnzsoasobsocsodsoesogsohsoisojsoksolsomsoosopsoqsorsossotsousovsowsox" +
+	"soysozspaspbspcspdspespgspispksplspmspnsposppspqsprspssptspuspvspxspysqa" +
+	"sqhsqisqksqmsqnsqosqqsqrsqssqtsqusqxsrasrbsrcsrdsresrfsrgsrhsrisrksrlsrm" +
+	"srnsrosrpsrqsrrsrssrtsrusrvsrwsrxsrysrzssbsscssdssessfssgsshssissjsskssl" +
+	"ssmssnssosspssqssrssssstssussvsswssxssysszstastbstdstestfstgsthstistjstk" +
+	"stlstmstnstostpstqstrstssttstustvstwstysuasubsucsuesugsuisujsuksunsuosuq" +
+	"sursussutsuvsuwsuxsuysuzsvasvbsvcsvesvksvmsvssvxswaswbswcsweswfswgswhswi" +
+	"swjswkswlswmswnswoswpswqswrswsswtswuswvswwswxswysxbsxcsxesxgsxksxlsxmsxn" +
+	"sxosxrsxssxusxwsyasybsycsyisyksylsymsynsyosyrsyssywsyxsyyszaszbszcszdsze" +
+	"szgszlsznszpszsszvszwszytaatabtactadtaetaftagtahtajtaktaltamtantaotaptaq" +
+	"tartastattautavtawtaxtaytaztbatbctbdtbetbftbgtbhtbitbjtbktbltbmtbntbotbp" +
+	"tbrtbstbttbutbvtbwtbxtbytbztcatcbtcctcdtcetcftcgtchtcitcktcltcmtcntcotcp" +
+	"tcqtcstcttcutcwtcxtcytcztdatdbtdctddtdetdftdgtdhtditdjtdktdltdmtdntdotdq" +
+	"tdrtdstdttdvtdxtdyteatebtectedteeteftegtehteitekteltemtenteotepteqtertes" +
+	"tetteutevtewtexteyteztfitfntfotfrtfttgatgbtgctgdtgetgftghtgitgjtgktgltgn" +
+	"tgotgptgqtgrtgstgttgutgvtgwtgxtgytgzthathdthethfthhthithkthlthmthnthpthq" +
+	"thrthsthtthuthvthythztiatictiftigtihtiitijtiktiltimtintiotiptiqtirtistit" +
+	"tiutivtiwtixtiytiztjatjgtjitjjtjltjmtjntjotjptjstjutjwtkatkbtkdtketkftkg" +
+	"tkltkmtkntkptkqtkrtkstkttkutkvtkwtkxtkztlatlbtlctldtlftlgtlhtlitljtlktll" +
+	"tlmtlntlotlptlqtlrtlstlttlutlvtlxtlytmatmbtmctmdtmetmftmgtmhtmitmjtmktml" +
+	"tmmtmntmotmqtmrtmstmttmutmvtmwtmytmztnatnbtnctndtngtnhtnitnktnltnmtnntno" +
+	"tnptnqtnrtnstnttnutnvtnwtnxtnytnztobtoctodtoftogtohtoitojtoktoltomtontoo" +
+	"toptoqtortostoutovtowtoxtoytoztpatpctpetpftpgtpitpjtpktpltpmtpntpotpptpq" +
+	"tprtpttputpvtpwtpxtpytpztqbtqltqmtqntqotqptqqtqrtqttqutqwtratrbtrctrdtre" +
+	"trftrgtrhtritrjtrltrmtrntrotrptrqtrrtrstrttrutrvtrwtrxtrytrztsatsbtsctsd" +
+	"tsetsgtshtsitsjtsktsltsmtsntsotsptsqtsrtsststtsutsvtswtsxtsytszttattbttc" +
+	"ttdttettfttgtthttittjttkttlttmttnttottpttqttrttstttttuttvttwttyttztuatub" +
+	"tuctudtuetuftugtuhtuitujtuktultumtuntuotuqturtustuutuvtuxtuytuztvatvdtve" +
+	"tvktvltvmtvntvotvstvttvutvwtvxtvytwatwbtwctwdtwetwftwgtwhtwitwltwmtwntwo" +
+	"twptwqtwrtwttwutwwtwxtwytxatxbtxctxetxgtxhtxitxjtxmtxntxotxqtxrtxstxttxu" +
+	"txxtxytyatyetyhtyityjtyltyntyptyrtystyttyutyvtyxtyytyztzatzhtzjtzltzmtzn" +
+	"tzotzxuamuanuarubaubiublubrubuubyudaudeudgudiudjudludmuduuesufiugaugbuge" +
+	"ughugnugougyuhauhnuiguisuivujiukaukgukhukiukkuklukpukqukruksukuukvukwuky" +
+	"ulaulbulculeulfuliulkullulmulnuluulwumaumbumcumdumgumiummumnumoumpumrums" +
+	"umuunaunduneunguniunkunmunnunrunuunxunzuonupiupvuraurburcurdureurfurgurh" +
+	"uriurkurlurmurnurourpurrurturuurvurwurxuryurzusaushusiuskuspussusuutaute" +
+	"uthutputrutuuumuuruuuuveuvhuvluwauyauzbuznuzsvaavaevafvagvahvaivajvalvam" +
+	"vanvaovapvarvasvauvavvayvbbvbkvecvedvelvemvenveovepvervgrvgtvicvidvievif" +
+	"vigvilvinvisvitvivvkavkjvkkvklvkmvknvkovkpvktvkuvkzvlpvlsvmavmbvmcvmdvme" +
+	"vmfvmgvmhvmivmjvmkvmlvmmvmpvmqvmrvmsvmuvmvvmwvmxvmyvmzvnkvnmvnpvolvorvot" +
+	"vravrovrsvrtvsivslvsvvtovumvunvutvwawaawabwacwadwaewafwagwahwaiwajwalwam" +
+	"wanwaowapwaqwarwaswatwauwavwawwaxwaywazwbawbbwbewbfwbhwbiwbjwbkwblwbmwbp" +
+	"wbqwbrwbswbtwbvwbwwcawciwddwdgwdjwdkwdtwduwdyweawecwedwegwehweiwemweowep" +
+	"werweswetweuwewwfgwgawgbwggwgiwgowguwgywhawhgwhkwhuwibwicwiewifwigwihwii" +
+	"wijwikwilwimwinwirwiuwivwiywjawjiwkawkbwkdwklwkrwkuwkwwkywlawlcwlewlgwlh" +
+	"wliwlkwllwlmwlnwlowlrwlswluwlvwlwwlxwlywmawmbwmcwmdwmewmgwmhwmiwmmwmnwmo" +
+	"wmswmtwmwwmxwnbwncwndwnewngwniwnkwnmwnnwnownpwnuwnwwnywoawobwocwodwoewof" +
+	"wogwoiwokwolwomwonwooworwoswowwoywpcwrbwrgwrhwriwrkwrlwrmwrnwrowrpwrrwrs" +
+	"wruwrvwrwwrxwrywrzwsawsgwsiwskwsrwsswsuwsvwtfwthwtiwtkwtmwtwwuawubwudwuh" +
+	"wulwumwunwurwutwuuwuvwuxwuywwawwbwwowwrwwwwxawxwwybwyiwymwynwyrwyyxaaxab" +
+	"xacxadxaexagxaixajxakxalxamxanxaoxapxaqxarxasxatxauxavxawxayxbbxbcxbdxbe" +
+	"xbgxbixbjxbmxbnxboxbpxbrxbwxbyxcbxccxcexcgxchxclxcmxcnxcoxcrxctxcuxcvxcw" +
+	"xcyxdaxdcxdkxdmxdoxdqxdyxebxedxegxelxemxepxerxesxetxeuxfaxgaxgbxgdxgfxgg" +
+	"xgixglxgmxgrxguxgwxhaxhcxhdxhexhmxhoxhrxhtxhuxhvxibxiixilxinxirxisxivxiy" +
+	"xjbxjtxkaxkbxkcxkdxkexkfxkgxkixkjxkkxklxknxkoxkpxkqxkrxksxktxkuxkvxkwxkx" +
+	"xkyxkzxlaxlbxlcxldxlexlgxlixlnxloxlpxlsxluxlyxmaxmbxmcxmdxmexmfxmgxmhxmj" +
+	"xmkxmlxmmxmnxmoxmpxmqxmrxmsxmtxmuxmvxmwxmxxmyxmzxnaxnbxngxnhxnixnjxnkxnm" +
+	"xnnxnoxnqxnrxnsxntxnuxnyxnzxocxodxogxoixokxomxonxooxopxorxowxpaxpbxpcxpd" +
+	"xpexpfxpgxphxpixpjxpkxplxpmxpnxpoxppxpqxprxpsxptxpuxpvxpwxpxxpyxpzxqaxqt" +
+	"xraxrbxrdxrexrgxrixrmxrnxrrxrtxruxrwxsaxsbxscxsdxsexshxsixsjxslxsmxsnxso" +
+	"xspxsqxsrxssxsuxsvxsyxtaxtbxtcxtdxtextgxthxtixtjxtlxtmxtnxtoxtpxtqxtrxts" +
+	"xttxtuxtvxtwxtyxuaxubxudxugxujxulxumxunxuoxupxurxutxuuxvexvixvnxvoxvsxwa" +
+	"xwcxwdxwexwgxwjxwkxwlxwoxwrxwtxwwxxbxxkxxmxxrxxtxyaxybxyjxykxylxytxyyxzh" +
+	"xzmxzpyaayabyacyadyaeyafyagyahyaiyajyakyalyamyanyaoyapyaqyaryasyatyauyav" +
+	"yawyaxyayyazybaybbybeybhybiybjybkyblybmybnyboybxybyychyclycnycpydayddyde" +
+	"ydgydkyeayecyeeyeiyejyelyeryesyetyeuyevyeyygaygiyglygmygpygrygsyguygwyha" +
+	"yhdyhlyhsyiayidyifyigyihyiiyijyikyilyimyinyipyiqyiryisyityiuyivyixyizyka" +
+	"ykgykiykkyklykmyknykoykryktykuykyylaylbyleylgyliyllylmylnyloylryluylyymb" +
+	"ymcymdymeymgymhymiymkymlymmymnymoympymqymrymsymxymzynayndyneyngynkynlynn" +
+	"ynoynqynsynuyobyogyoiyokyolyomyonyoryotyoxyoyypaypbypgyphypmypnypoyppypz" +
+	"yrayrbyreyrkyrlyrmyrnyroyrsyrwyryyscysdysgyslysmysnysoyspysryssysyytaytl" +
+	"ytpytwytyyuayubyucyudyueyufyugyuiyujyukyulyumyunyupyuqyuryutyuwyuxyuyyuz" +
+	"yvayvtywaywgywlywnywqywrywtywuywwyxayxgyxlyxmyxuyxyyyryyuyyzyzgyzkzaazab" +
+	"zaczadzaezafzagzahzaizajzakzalzamzaozapzaqzarzaszatzauzavzawzaxzayzazzba" +
+	"zbczbezblzbtzbuzbwzcazcdzchzdjzeazegzehzenzgazgbzghzgmzgnzgrzhazhbzhdzhi" +
+	"zhnzhozhwziazibzikzilzimzinziwzizzkazkbzkdzkgzkhzkkzknzkozkpzkrzktzkuzkv" +
+	"zkzzlazljzlmzlnzlqzmazmbzmczmdzmezmfzmgzmhzmizmjzmkzmlzmmzmnzmozmpzmqzmr" +
+	"zmszmtzmuzmvzmwzmxzmyzmzznaznezngznkznszoczohzomzoozoqzorzoszpazpbzpczpd" +
+	"zpezpfzpgzphzpizpjzpkzplzpmzpnzpozppzpqzprzpszptzpuzpvzpwzpxzpyzpzzqezra" +
+	"zrgzrnzrozrpzrszsazskzslzsmzsrzsuzteztgztlztmztnztpztqztszttztuztxztyzua" +
+	"zuhzulzumzunzuyzwazxxzybzygzyjzynzypzzazzj"
@@ -0,0 +1,770 @@
+package is
+
+import (
+	"sort"
+	"strings"
+)
+
+// ISO639Entry stores language codes
+type ISO639Entry struct {
+	EnglishName string
+	// Alpha2Code is the ISO 639-1 code, or empty if the language has none.
+	Alpha2Code string
+	// Alpha3Code is the ISO 639-2/T (terminology) code.
+	Alpha3Code string
+	// BibliographicCode is the ISO 639-2/B code where it differs from Alpha3Code.
+	BibliographicCode string
+}
+
+// ISO639List based on https://www.loc.gov/standards/iso639-2/php/code_list.php
+// The range qaa-qtz reserved for local use is not listed.
+var ISO639List = []ISO639Entry{
+	{"Afar", "aa", "aar", ""},
+	{"Abkhazian", "ab", "abk", ""},
+	{"Achinese", "", "ace", ""},
+	{"Acoli", "", "ach", ""},
+	{"Adangme", "", "ada", ""},
+	{"Adyghe; Adygei", "", "ady", ""},
+	{"Afro-Asiatic languages", "", "afa", ""},
+	{"Afrihili", "", "afh", ""},
+	{"Afrikaans", "af", "afr", ""},
+	{"Ainu", "", "ain", ""},
+	{"Akan", "ak", "aka", ""},
+	{"Akkadian", "", "akk", ""},
+	{"Aleut", "", "ale", ""},
+	{"Algonquian languages", "", "alg", ""},
+	{"Southern Altai", "", "alt", ""},
+	{"Amharic", "am", "amh", ""},
+	{"English, Old (ca. 450-1100)", "", "ang", ""},
+	{"Angika", "", "anp", ""},
+	{"Apache languages", "", "apa", ""},
+	{"Arabic", "ar", "ara", ""},
+	{"Official Aramaic (700-300 BCE); Imperial Aramaic (700-300 BCE)", "", "arc", ""},
+	{"Aragonese", "an", "arg", ""},
+	{"Mapudungun; Mapuche", "", "arn", ""},
+	{"Arapaho", "", "arp", ""},
+	{"Artificial languages", "", "art", ""},
+	{"Arawak", "", "arw", ""},
+	{"Assamese", "as", "asm", ""},
+	{"Asturian; Bable; Leonese; Asturleonese", "", "ast", ""},
+	{"Athapascan languages", "", "ath", ""},
+	{"Australian languages", "", "aus", ""},
+	{"Avaric", "av", "ava", ""},
+	{"Avestan", "ae", "ave", ""},
+	{"Awadhi", "", "awa", ""},
+	{"Aymara", "ay", "aym", ""},
+	{"Azerbaijani", "az", "aze", ""},
+	{"Banda languages", "", "bad", ""},
+	{"Bamileke languages", "", "bai", ""},
+	{"Bashkir", "ba", "bak", ""},
+	{"Baluchi", "", "bal", ""},
+	{"Bambara", "bm", "bam", ""},
+	{"Balinese", "", "ban", ""},
+	{"Basa", "", "bas", ""},
+	{"Baltic languages", "", "bat", ""},
+	{"Beja; Bedawiyet", "", "bej", ""},
+	{"Belarusian", "be", "bel", ""},
+	{"Bemba", "", "bem", ""},
+	{"Bengali", "bn", "ben", ""},
+	{"Berber languages", "", "ber", ""},
+	{"Bhojpuri", "", "bho", ""},
+	{"Bihari languages", "bh", "bih", ""},
+	{"Bikol", "", "bik", ""},
+	{"Bini; Edo", "", "bin", ""},
+	{"Bislama", "bi", "bis", ""},
+	{"Siksika", "", "bla", ""},
+	{"Bantu (Other)", "", "bnt", ""},
+	{"Tibetan", "bo", "bod", "tib"},
+	{"Bosnian", "bs", "bos", ""},
+	{"Braj", "", "bra", ""},
+	{"Breton", "br", "bre", ""},
+	{"Batak languages", "", "btk", ""},
+	{"Buriat", "", "bua", ""},
+	{"Buginese", "", "bug", ""},
+	{"Bulgarian", "bg", "bul", ""},
+	{"Blin; Bilin", "", "byn", ""},
+	{"Caddo", "", "cad", ""},
+	{"Central American Indian languages", "", "cai", ""},
+	{"Galibi Carib", "", "car", ""},
+	{"Catalan; Valencian", "ca", "cat", ""},
+	{"Caucasian languages", "", "cau", ""},
+	{"Cebuano", "", "ceb", ""},
+	{"Celtic languages", "", "cel", ""},
+	{"Czech", "cs", "ces", "cze"},
+	{"Chamorro", "ch", "cha", ""},
+	{"Chibcha", "", "chb", ""},
+	{"Chechen", "ce", "che", ""},
+	{"Chagatai", "", "chg", ""},
+	{"Chuukese", "", "chk", ""},
+	{"Mari", "", "chm", ""},
+	{"Chinook jargon", "", "chn", ""},
+	{"Choctaw", "", "cho", ""},
+	{"Chipewyan; Dene Suline", "", "chp", ""},
+	{"Cherokee", "", "chr", ""},
+	{"Church Slavic; Old Slavonic; Church Slavonic; Old Bulgarian; Old Church Slavonic", "cu", "chu", ""},
+	{"Chuvash", "cv", "chv", ""},
+	{"Cheyenne", "", "chy", ""},
+	{"Chamic languages", "", "cmc", ""},
+	{"Montenegrin", "", "cnr", ""},
+	{"Coptic", "", "cop", ""},
+	{"Cornish", "kw", "cor", ""},
+	{"Corsican", "co", "cos", ""},
+	{"Creoles and pidgins, English based", "", "cpe", ""},
+	{"Creoles and pidgins, French-based", "", "cpf", ""},
+	{"Creoles and pidgins, Portuguese-based", "", "cpp", ""},
+	{"Cree", "cr", "cre", ""},
+	{"Crimean Tatar; Crimean Turkish", "", "crh", ""},
+	{"Creoles and pidgins", "", "crp", ""},
+	{"Kashubian", "", "csb", ""},
+	{"Cushitic languages", "", "cus", ""},
+	{"Welsh", "cy", "cym", "wel"},
+	{"Dakota", "", "dak", ""},
+	{"Danish", "da", "dan", ""},
+	{"Dargwa", "", "dar", ""},
+	{"Land Dayak languages", "", "day", ""},
+	{"Delaware", "", "del", ""},
+	{"Slave (Athapascan)", "", "den", ""},
+	{"German", "de", "deu", "ger"},
+	{"Dogrib", "", "dgr", ""},
+	{"Dinka", "", "din", ""},
+	{"Divehi; Dhivehi; Maldivian", "dv", "div", ""},
+	{"Dogri", "", "doi", ""},
+	{"Dravidian languages", "", "dra", ""},
+	{"Lower Sorbian", "", "dsb", ""},
+	{"Duala", "", "dua", ""},
+	{"Dutch, Middle (ca. 1050-1350)", "", "dum", ""},
+	{"Dyula", "", "dyu", ""},
+	{"Dzongkha", "dz", "dzo", ""},
+	{"Efik", "", "efi", ""},
+	{"Egyptian (Ancient)", "", "egy", ""},
+	{"Ekajuk", "", "eka", ""},
+	{"Greek, Modern (1453-)", "el", "ell", "gre"},
+	{"Elamite", "", "elx", ""},
+	{"English", "en", "eng", ""},
+	{"English, Middle (1100-1500)", "", "enm", ""},
+	{"Esperanto", "eo", "epo", ""},
+	{"Estonian", "et", "est", ""},
+	{"Basque", "eu", "eus", "baq"},
+	{"Ewe", "ee", "ewe", ""},
+	{"Ewondo", "", "ewo", ""},
+	{"Fang", "", "fan", ""},
+	{"Faroese", "fo", "fao", ""},
+	{"Persian", "fa", "fas", "per"},
+	{"Fanti", "", "fat", ""},
+	{"Fijian", "fj", "fij", ""},
+	{"Filipino; Pilipino", "", "fil", ""},
+	{"Finnish", "fi", "fin", ""},
+	{"Finno-Ugrian languages", "", "fiu", ""},
+	{"Fon", "", "fon", ""},
+	{"French", "fr", "fra", "fre"},
+	{"French, Middle (ca. 1400-1600)", "", "frm", ""},
+	{"French, Old (842-ca. 1400)", "", "fro", ""},
+	{"Northern Frisian", "", "frr", ""},
+	{"Eastern Frisian", "", "frs", ""},
+	{"Western Frisian", "fy", "fry", ""},
+	{"Fulah", "ff", "ful", ""},
+	{"Friulian", "", "fur", ""},
+	{"Ga", "", "gaa", ""},
+	{"Gayo", "", "gay", ""},
+	{"Gbaya", "", "gba", ""},
+	{"Germanic languages", "", "gem", ""},
+	{"Geez", "", "gez", ""},
+	{"Gilbertese", "", "gil", ""},
+	{"Gaelic; Scottish Gaelic", "gd", "gla", ""},
+	{"Irish", "ga", "gle", ""},
+	{"Galician", "gl", "glg", ""},
+	{"Manx", "gv", "glv", ""},
+	{"German, Middle High (ca. 1050-1500)", "", "gmh", ""},
+	{"German, Old High (ca. 750-1050)", "", "goh", ""},
+	{"Gondi", "", "gon", ""},
+	{"Gorontalo", "", "gor", ""},
+	{"Gothic", "", "got", ""},
+	{"Grebo", "", "grb", ""},
+	{"Greek, Ancient (to 1453)", "", "grc", ""},
+	{"Guarani", "gn", "grn", ""},
+	{"Swiss German; Alemannic; Alsatian", "", "gsw", ""},
+	{"Gujarati", "gu", "guj", ""},
+	{"Gwich'in", "", "gwi", ""},
+	{"Haida", "", "hai", ""},
+	{"Haitian; Haitian Creole", "ht", "hat", ""},
+	{"Hausa", "ha", "hau", ""},
+	{"Hawaiian", "", "haw", ""},
+	{"Hebrew", "he", "heb", ""},
+	{"Herero", "hz", "her", ""},
+	{"Hiligaynon", "", "hil", ""},
+	{"Himachali languages; Western Pahari languages", "", "him", ""},
+	{"Hindi", "hi", "hin", ""},
+	{"Hittite", "", "hit", ""},
+	{"Hmong; Mong", "", "hmn", ""},
+	{"Hiri Motu", "ho", "hmo", ""},
+	{"Croatian", "hr", "hrv", ""},
+	{"Upper Sorbian", "", "hsb", ""},
+	{"Hungarian", "hu", "hun", ""},
+	{"Hupa", "", "hup", ""},
+	{"Armenian", "hy", "hye", "arm"},
+	{"Iban", "", "iba", ""},
+	{"Igbo", "ig", "ibo", ""},
+	{"Ido", "io", "ido", ""},
+	{"Sichuan Yi; Nuosu", "ii", "iii", ""},
+	{"Ijo languages", "", "ijo", ""},
+	{"Inuktitut", "iu", "iku", ""},
+	{"Interlingue; Occidental", "ie", "ile", ""},
+	{"Iloko", "", "ilo", ""},
+	{"Interlingua (International Auxiliary Language Association)", "ia", "ina", ""},
+	{"Indic languages", "", "inc", ""},
+	{"Indonesian", "id", "ind", ""},
+	{"Indo-European languages", "", "ine", ""},
+	{"Ingush", "", "inh", ""},
+	{"Inupiaq", "ik", "ipk", ""},
+	{"Iranian languages", "", "ira", ""},
+	{"Iroquoian languages", "", "iro", ""},
+	{"Icelandic", "is", "isl", "ice"},
+	{"Italian", "it", "ita", ""},
+	{"Javanese", "jv", "jav", ""},
+	{"Lojban", "", "jbo", ""},
+	{"Japanese", "ja", "jpn", ""},
+	{"Judeo-Persian", "", "jpr", ""},
+	{"Judeo-Arabic", "", "jrb", ""},
+	{"Kara-Kalpak", "", "kaa", ""},
+	{"Kabyle", "", "kab", ""},
+	{"Kachin; Jingpho", "", "kac", ""},
+	{"Kalaallisut; Greenlandic", "kl", "kal", ""},
+	{"Kamba", "", "kam", ""},
+	{"Kannada", "kn", "kan", ""},
+	{"Karen languages", "", "kar", ""},
+	{"Kashmiri", "ks", "kas", ""},
+	{"Georgian", "ka", "kat", "geo"},
+	{"Kanuri", "kr", "kau", ""},
+	{"Kawi", "", "kaw", ""},
+	{"Kazakh", "kk", "kaz", ""},
+	{"Kabardian", "", "kbd", ""},
+	{"Khasi", "", "kha", ""},
+	{"Khoisan languages", "", "khi", ""},
+	{"Central Khmer", "km", "khm", ""},
+	{"Khotanese; Sakan", "", "kho", ""},
+	{"Kikuyu; Gikuyu", "ki", "kik", ""},
+	{"Kinyarwanda", "rw", "kin", ""},
+	{"Kirghiz; Kyrgyz", "ky", "kir", ""},
+	{"Kimbundu", "", "kmb", ""},
+	{"Konkani", "", "kok", ""},
+	{"Komi", "kv", "kom", ""},
+	{"Kongo", "kg", "kon", ""},
+	{"Korean", "ko", "kor", ""},
+	{"Kosraean", "", "kos", ""},
+	{"Kpelle", "", "kpe", ""},
+	{"Karachay-Balkar", "", "krc", ""},
+	{"Karelian", "", "krl", ""},
+	{"Kru languages", "", "kro", ""},
+	{"Kurukh", "", "kru", ""},
+	{"Kuanyama; Kwanyama", "kj", "kua", ""},
+	{"Kumyk", "", "kum", ""},
+	{"Kurdish", "ku", "kur", ""},
+	{"Kutenai", "", "kut", ""},
+	{"Ladino", "", "lad", ""},
+	{"Lahnda", "", "lah", ""},
+	{"Lamba", "", "lam", ""},
+	{"Lao", "lo", "lao", ""},
+	{"Latin", "la", "lat", ""},
+	{"Latvian", "lv", "lav", ""},
+	{"Lezghian", "", "lez", ""},
+	{"Limburgan; Limburger; Limburgish", "li", "lim", ""},
+	{"Lingala", "ln", "lin", ""},
+	{"Lithuanian", "lt", "lit", ""},
+	{"Mongo", "", "lol", ""},
+	{"Lozi", "", "loz", ""},
+	{"Luxembourgish; Letzeburgesch", "lb", "ltz", ""},
+	{"Luba-Lulua", "", "lua", ""},
+	{"Luba-Katanga", "lu", "lub", ""},
+	{"Ganda", "lg", "lug", ""},
+	{"Luiseno", "", "lui", ""},
+	{"Lunda", "", "lun", ""},
+	{"Luo (Kenya and Tanzania)", "", "luo", ""},
+	{"Lushai", "", "lus", ""},
+	{"Madurese", "", "mad", ""},
+	{"Magahi", "", "mag", ""},
+	{"Marshallese", "mh", "mah", ""},
+	{"Maithili", "", "mai", ""},
+	{"Makasar", "", "mak", ""},
+	{"Malayalam", "ml", "mal", ""},
+	{"Mandingo", "", "man", ""},
+	{"Austronesian languages", "", "map", ""},
+	{"Marathi", "mr", "mar", ""},
+	{"Masai", "", "mas", ""},
+	{"Moksha", "", "mdf", ""},
+	{"Mandar", "", "mdr", ""},
+	{"Mende", "", "men", ""},
+	{"Irish, Middle (900-1200)", "", "mga", ""},
+	{"Mi'kmaq; Micmac", "", "mic", ""},
+	{"Minangkabau", "", "min", ""},
+	{"Uncoded languages", "", "mis", ""},
+	{"Macedonian", "mk", "mkd", "mac"},
+	{"Mon-Khmer languages", "", "mkh", ""},
+	{"Malagasy", "mg", "mlg", ""},
+	{"Maltese", "mt", "mlt", ""},
+	{"Manchu", "", "mnc", ""},
+	{"Manipuri", "", "mni", ""},
+	{"Manobo languages", "", "mno", ""},
+	{"Mohawk", "", "moh", ""},
+	{"Mongolian", "mn", "mon", ""},
+	{"Mossi", "", "mos", ""},
+	{"Maori", "mi", "mri", "mao"},
+	{"Malay", "ms", "msa", "may"},
+	{"Multiple languages", "", "mul", ""},
+	{"Munda languages", "", "mun", ""},
+	{"Creek", "", "mus", ""},
+	{"Mirandese", "", "mwl", ""},
+	{"Marwari", "", "mwr", ""},
+	{"Burmese", "my", "mya", "bur"},
+	{"Mayan languages", "", "myn", ""},
+	{"Erzya", "", "myv", ""},
+	{"Nahuatl languages", "", "nah", ""},
+	{"North American Indian languages", "", "nai", ""},
+	{"Neapolitan", "", "nap", ""},
+	{"Nauru", "na", "nau", ""},
+	{"Navajo; Navaho", "nv", "nav", ""},
+	{"Ndebele, South; South Ndebele", "nr", "nbl", ""},
+	{"Ndebele, North; North Ndebele", "nd", "nde", ""},
+	{"Ndonga", "ng", "ndo", ""},
+	{"Low German; Low Saxon; German, Low; Saxon, Low", "", "nds", ""},
+	{"Nepali", "ne", "nep", ""},
+	{"Nepal Bhasa; Newari", "", "new", ""},
+	{"Nias", "", "nia", ""},
+	{"Niger-Kordofanian languages", "", "nic", ""},
+	{"Niuean", "", "niu", ""},
+	{"Dutch; Flemish", "nl", "nld", "dut"},
+	{"Norwegian Nynorsk; Nynorsk, Norwegian", "nn", "nno", ""},
+	{"Bokmål, Norwegian; Norwegian Bokmål", "nb", "nob", ""},
+	{"Nogai", "", "nog", ""},
+	{"Norse, Old", "", "non", ""},
+	{"Norwegian", "no", "nor", ""},
+	{"N'Ko", "", "nqo", ""},
+	{"Pedi; Sepedi; Northern Sotho", "", "nso", ""},
+	{"Nubian languages", "", "nub", ""},
+	{"Classical Newari; Old Newari; Classical Nepal Bhasa", "", "nwc", ""},
+	{"Chichewa; Chewa; Nyanja", "ny", "nya", ""},
+	{"Nyamwezi", "", "nym", ""},
+	{"Nyankole", "", "nyn", ""},
+	{"Nyoro", "", "nyo", ""},
+	{"Nzima", "", "nzi", ""},
+	{"Occitan (post 1500); Provençal", "oc", "oci", ""},
+	{"Ojibwa", "oj", "oji", ""},
+	{"Oriya", "or", "ori", ""},
+	{"Oromo", "om", "orm", ""},
+	{"Osage", "", "osa", ""},
+	{"Ossetian; Ossetic", "os", "oss", ""},
+	{"Turkish, Ottoman (1500-1928)", "", "ota", ""},
+	{"Otomian languages", "", "oto", ""},
+	{"Papuan languages", "", "paa", ""},
+	{"Pangasinan", "", "pag", ""},
+	{"Pahlavi", "", "pal", ""},
+	{"Pampanga; Kapampangan", "", "pam", ""},
+	{"Panjabi; Punjabi", "pa", "pan", ""},
+	{"Papiamento", "", "pap", ""},
+	{"Palauan", "", "pau", ""},
+	{"Persian, Old (ca. 600-400 B.C.)", "", "peo", ""},
+	{"Philippine languages", "", "phi", ""},
+	{"Phoenician", "", "phn", ""},
+	{"Pali", "pi", "pli", ""},
+	{"Polish", "pl", "pol", ""},
+	{"Pohnpeian", "", "pon", ""},
+	{"Portuguese", "pt", "por", ""},
+	{"Prakrit languages", "", "pra", ""},
+	{"Provençal, Old (to 1500)", "", "pro", ""},
+	{"Pushto; Pashto", "ps", "pus", ""},
+	{"Quechua", "qu", "que", ""},
+	{"Rajasthani", "", "raj", ""},
+	{"Rapanui", "", "rap", ""},
+	{"Rarotongan; Cook Islands Maori", "", "rar", ""},
+	{"Romance languages", "", "roa", ""},
+	{"Romansh", "rm", "roh", ""},
+	{"Romany", "", "rom", ""},
+	{"Romanian; Moldavian; Moldovan", "ro", "ron", "rum"},
+	{"Rundi", "rn", "run", ""},
+	{"Aromanian; Arumanian; Macedo-Romanian", "", "rup", ""},
+	{"Russian", "ru", "rus", ""},
+	{"Sandawe", "", "sad", ""},
+	{"Sango", "sg", "sag", ""},
+	{"Yakut", "", "sah", ""},
+	{"South American Indian (Other)", "", "sai", ""},
+	{"Salishan languages", "", "sal", ""},
+	{"Samaritan Aramaic", "", "sam", ""},
+	{"Sanskrit", "sa", "san", ""},
+	{"Sasak", "", "sas", ""},
+	{"Santali", "", "sat", ""},
+	{"Sicilian", "", "scn", ""},
+	{"Scots", "", "sco", ""},
+	{"Selkup", "", "sel", ""},
+	{"Semitic languages", "", "sem", ""},
+	{"Irish, Old (to 900)", "", "sga", ""},
+	{"Sign Languages", "", "sgn", ""},
+	{"Shan", "", "shn", ""},
+	{"Sidamo", "", "sid", ""},
+	{"Sinhala; Sinhalese", "si", "sin", ""},
+	{"Siouan languages", "", "sio", ""},
+	{"Sino-Tibetan languages", "", "sit", ""},
+	{"Slavic languages", "", "sla", ""},
+	{"Slovak", "sk", "slk", "slo"},
+	{"Slovenian", "sl", "slv", ""},
+	{"Southern Sami", "", "sma", ""},
+	{"Northern Sami", "se", "sme", ""},
+	{"Sami languages", "", "smi", ""},
+	{"Lule Sami", "", "smj", ""},
+	{"Inari Sami", "", "smn", ""},
+	{"Samoan", "sm", "smo", ""},
+	{"Skolt Sami", "", "sms", ""},
+	{"Shona", "sn", "sna", ""},
+	{"Sindhi", "sd", "snd", ""},
+	{"Soninke", "", "snk", ""},
+	{"Sogdian", "", "sog", ""},
+	{"Somali", "so", "som", ""},
+	{"Songhai languages", "", "son", ""},
+	{"Sotho, Southern", "st", "sot", ""},
+	{"Spanish; Castilian", "es", "spa", ""},
+	{"Albanian", "sq", "sqi", "alb"},
+	{"Sardinian", "sc", "srd", ""},
+	{"Sranan Tongo", "", "srn", ""},
+	{"Serbian", "sr", "srp", ""},
+	{"Serer", "", "srr", ""},
+	{"Nilo-Saharan languages", "", "ssa", ""},
+	{"Swati", "ss", "ssw", ""},
+	{"Sukuma", "", "suk", ""},
+	{"Sundanese", "su", "sun", ""},
+	{"Susu", "", "sus", ""},
+	{"Sumerian", "", "sux", ""},
+	{"Swahili", "sw", "swa", ""},
+	{"Swedish", "sv", "swe", ""},
+	{"Classical Syriac", "", "syc", ""},
+	{"Syriac", "", "syr", ""},
+	{"Tahitian", "ty", "tah", ""},
+	{"Tai languages", "", "tai", ""},
+	{"Tamil", "ta", "tam", ""},
+	{"Tatar", "tt", "tat", ""},
+	{"Telugu", "te", "tel", ""},
+	{"Timne", "", "tem", ""},
+	{"Tereno", "", "ter", ""},
+	{"Tetum", "", "tet", ""},
+	{"Tajik", "tg", "tgk", ""},
+	{"Tagalog", "tl", "tgl", ""},
+	{"Thai", "th", "tha", ""},
+	{"Tigre", "", "tig", ""},
+	{"Tigrinya", "ti", "tir", ""},
+	{"Tiv", "", "tiv", ""},
+	{"Tokelau", "", "tkl", ""},
+	{"Klingon; tlhIngan-Hol", "", "tlh", ""},
+	{"Tlingit", "", "tli", ""},
+	{"Tamashek", "", "tmh", ""},
+	{"Tonga (Nyasa)", "", "tog", ""},
+	{"Tonga (Tonga Islands)", "to", "ton", ""},
+	{"Tok Pisin", "", "tpi", ""},
+	{"Tsimshian", "", "tsi", ""},
+	{"Tswana", "tn", "tsn", ""},
+	{"Tsonga", "ts", "tso", ""},
+	{"Turkmen", "tk", "tuk", ""},
+	{"Tumbuka", "", "tum", ""},
+	{"Tupi languages", "", "tup", ""},
+	{"Turkish", "tr", "tur", ""},
+	{"Altaic languages", "", "tut", ""},
+	{"Tuvalu", "", "tvl", ""},
+	{"Twi", "tw", "twi", ""},
+	{"Tuvinian", "", "tyv", ""},
+	{"Udmurt", "", "udm", ""},
+	{"Ugaritic", "", "uga", ""},
+	{"Uighur; Uyghur", "ug", "uig", ""},
+	{"Ukrainian", "uk", "ukr", ""},
+	{"Umbundu", "", "umb", ""},
+	{"Undetermined", "", "und", ""},
+	{"Urdu", "ur", "urd", ""},
+	{"Uzbek", "uz", "uzb", ""},
+	{"Vai", "", "vai", ""},
+	{"Venda", "ve", "ven", ""},
+	{"Vietnamese", "vi", "vie", ""},
+	{"Volapük", "vo", "vol", ""},
+	{"Votic", "", "vot", ""},
+	{"Wakashan languages", "", "wak", ""},
+	{"Walamo", "", "wal", ""},
+	{"Waray", "", "war", ""},
+	{"Washo", "", "was", ""},
+	{"Sorbian languages", "", "wen", ""},
+	{"Walloon", "wa", "wln", ""},
+	{"Wolof", "wo", "wol", ""},
+	{"Kalmyk; Oirat", "", "xal", ""},
+	{"Xhosa", "xh", "xho", ""},
+	{"Yao", "", "yao", ""},
+	{"Yapese", "", "yap", ""},
+	{"Yiddish", "yi", "yid", ""},
+	{"Yoruba", "yo", "yor", ""},
+	{"Yupik languages", "", "ypk", ""},
+	{"Zapotec", "", "zap", ""},
+	{"Blissymbols; Blissymbolics; Bliss", "", "zbl", ""},
+	{"Zenaga", "", "zen", ""},
+	{"Standard Moroccan Tamazight", "", "zgh", ""},
+	{"Zhuang; Chuang", "za", "zha", ""},
+	{"Chinese", "zh", "zho", "chi"},
+	{"Zande languages", "", "znd", ""},
+	{"Zulu", "zu", "zul", ""},
+	{"Zuni", "", "zun", ""},
+	{"No linguistic content; Not applicable", "", "zxx", ""},
+	{"Zaza; Dimili; Dimli; Kirdki; Kirmanjki; Zazaki", "", "zza", ""},
+}
+
+// ISO6391 check if the string is a two-letter ISO 639-1 language code, e.g. en.
+func ISO6391(str string) bool {
+	if len(str) != 2 {
+		return false
+	}
+	for _, entry := range ISO639List {
+		if str == entry.Alpha2Code {
+			return true
+		}
+	}
+	return false
+}
+
+// ISO6392 check if the string is a three-letter ISO 639-2 language code,
+// either terminology (deu) or bibliographic (ger), including the range
+// qaa-qtz reserved for local use.
+func ISO6392(str string) bool {
+	if len(str) != 3 {
+		return false
+	}
+	if iso639Local(str) {
+		return true
+	}
+	for _, entry := range ISO639List {
+		if str == entry.Alpha3Code || str == entry.BibliographicCode {
+			return true
+		}
+	}
+	return false
+}
+
+// ISO6393 check if the string is a three-letter ISO 639-3 language code,
+// e.g. cmn, including the range qaa-qtz reserved for local use.
+func ISO6393(str string) bool {
+	if len(str) != 3 {
+		return false
+	}
+	if iso639Local(str) {
+		return true
+	}
+	n := len(iso6393Codes) / 3
+	i := sort.Search(n, func(i int) bool { return iso6393Codes[3*i:3*i+3] >= str })
+	return i < n && iso6393Codes[3*i:3*i+3] == str
+}
+
+// iso6392Terminology check if the string is an ISO 639-2/T code. Unlike the
+// bibliographic codes, these include collective codes absent from ISO 639-3.
+func iso6392Terminology(str string) bool {
+	for _, entry := range ISO639List {
+		if str == entry.Alpha3Code {
+			return true
+		}
+	}
+	return false
+}
+
+// iso639Local check if the three-letter code is reserved for local use.
+func iso639Local(str string) bool {
+	return "qaa" <= str && str <= "qtz"
+}
+
+// LanguageTagParts is a BCP 47 language tag split into its subtags, in
+// canonical case.
+type LanguageTagParts struct {
+	// Language is the primary language subtag, e.g. zh. For a grandfathered
+	// tag it is the whole tag, e.g. i-klingon, and the other fields are empty.
+	Language string
+	// ExtLang lists the extended language subtags, e.g. yue in zh-yue.
+	ExtLang []string
+	// Script is the ISO 15924 script subtag, e.g. Hant.
+	Script string
+	// Region is the ISO 3166 alpha-2 or UN M.49 region subtag, e.g. TW or 419.
+	Region string
+	// Variants lists the variant subtags, e.g. valencia in ca-ES-valencia.
+	Variants []string
+	// Extensions lists each extension with its singleton, e.g. u-ca-gregory.
+	Extensions []string
+	// PrivateUse is the private use section, e.g. x-phonebook.
+	PrivateUse string
+	// Grandfathered reports whether the tag is one of the grandfathered tags
+	// of RFC 5646 section 2.2.8.
+	Grandfathered bool
+}
+
+// String returns the language tag.
+func (p LanguageTagParts) String() string {
+	var subtags []string
+	add := func(s ...string) {
+		for _, v := range s {
+			if v != "" {
+				subtags = append(subtags, v)
+			}
+		}
+	}
+	add(p.Language)
+	add(p.ExtLang...)
+	add(p.Script, p.Region)
+	add(p.Variants...)
+	add(p.Extensions...)
+	add(p.PrivateUse)
+	return strings.Join(subtags, "-")
+}
+
+// languageTagGrandfathered lists the grandfathered tags of RFC 5646.
+var languageTagGrandfathered = []string{
+	"en-GB-oed", "i-ami", "i-bnn", "i-default", "i-enochian", "i-hak", "i-klingon",
+	"i-lux", "i-mingo", "i-navajo", "i-pwn", "i-tao", "i-tay", "i-tsu", "sgn-BE-FR",
+	"sgn-BE-NL", "sgn-CH-DE", "art-lojban", "cel-gaulish", "no-bok", "no-nyn",
+	"zh-guoyu", "zh-hakka", "zh-min", "zh-min-nan", "zh-xiang",
+}
+
+// languageTagM49 lists the UN M.49 area codes that are valid region subtags
+// besides the numeric codes of ISO3166List.
+var languageTagM49 = []string{
+	"001", "002", "003", "005", "009", "011", "013", "014", "015", "017", "018",
+	"019", "021", "029", "030", "034", "035", "039", "053", "054", "057", "061",
+	"142", "143", "145", "150", "151", "154", "155", "202", "419",
+}
+
+// LanguageTag check if the string is a BCP 47 (RFC 5646) language tag such as
+// en-GB, zh-Hant-TW or sr-Latn-RS-u-ca-gregory. Tags are case-insensitive.
+// Language subtags must be listed in ISO639List or ISO 639-3 and region
+// subtags in ISO3166List or be a UN M.49 area code; the other subtags are
+// only checked for syntax.
+func LanguageTag(str string) bool {
+	_, err := ParseLanguageTag(str)
+	return err == nil
+}
+
+// ParseLanguageTag validates a language tag like LanguageTag and returns its
+// subtags. On failure the error is a *ValidationError whose offset points at
+// the offending subtag.
+func ParseLanguageTag(str string) (LanguageTagParts, error) {
+	var parts LanguageTagParts
+	if str == "" {
+		return parts, invalid("LanguageTag", CodeEmpty, -1)
+	}
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; c != '-' && !isAlnum(c) {
+			return parts, invalid("LanguageTag", CodeInvalidChar, i)
+		}
+	}
+	for _, g := range languageTagGrandfathered {
+		if strings.EqualFold(str, g) {
+			return LanguageTagParts{Language: g, Grandfathered: true}, nil
+		}
+	}
+
+	// subtags and their offsets in str
+	var subtags []string
+	var offsets []int
+	start := 0
+	for i := 0; i <= len(str); i++ {
+		if i < len(str) && str[i] != '-' {
+			continue
+		}
+		if i == start || i-start > 8 {
+			return parts, invalid("LanguageTag", CodeBadFormat, start)
+		}
+		subtags = append(subtags, strings.ToLower(str[start:i]))
+		offsets = append(offsets, start)
+		start = i + 1
+	}
+
+	i := 0
+	next := func(ok func(string) bool) bool {
+		return i < len(subtags) && ok(subtags[i])
+	}
+	if subtags[0] != "x" {
+		lang := subtags[0]
+		if len(lang) < 2 || len(lang) > 3 || !Alpha(lang) {
+			return parts, invalid("LanguageTag", CodeBadFormat, 0)
+		}
+		if !ISO6391(lang) && !ISO6393(lang) && !iso6392Terminology(lang) {
+			return parts, invalid("LanguageTag", CodeUnknownLanguage, 0)
+		}
+		parts.Language = lang
+		i++
+		for len(parts.ExtLang) < 3 && next(func(s string) bool { return len(s) == 3 && Alpha(s) }) {
+			if !ISO6393(subtags[i]) {
+				return parts, invalid("LanguageTag", CodeUnknownLanguage, offsets[i])
+			}
+			parts.ExtLang = append(parts.ExtLang, subtags[i])
+			i++
+		}
+		if next(func(s string) bool { return len(s) == 4 && Alpha(s) }) {
+			parts.Script = strings.ToUpper(subtags[i][:1]) + subtags[i][1:]
+			i++
+		}
+		if next(func(s string) bool { return (len(s) == 2 && Alpha(s)) || (len(s) == 3 && Numeric(s)) }) {
+			region := strings.ToUpper(subtags[i])
+			if !ISO3166Alpha2(region) && !languageTagNumericRegion(region) {
+				return parts, invalid("LanguageTag", CodeUnknownCountry, offsets[i])
+			}
+			parts.Region = region
+			i++
+		}
+		for next(languageTagVariant) {
+			for _, v := range parts.Variants {
+				if v == subtags[i] {
+					return parts, invalid("LanguageTag", CodeBadFormat, offsets[i])
+				}
+			}
+			parts.Variants = append(parts.Variants, subtags[i])
+			i++
+		}
+		for next(func(s string) bool { return len(s) == 1 && s != "x" }) {
+			singleton := subtags[i]
+			for _, e := range parts.Extensions {
+				if e[:1] == singleton {
+					return parts, invalid("LanguageTag", CodeBadFormat, offsets[i])
+				}
+			}
+			j := i + 1
+			for j < len(subtags) && len(subtags[j]) >= 2 {
+				j++
+			}
+			if j == i+1 {
+				return parts, invalid("LanguageTag", CodeBadFormat, offsets[i])
+			}
+			parts.Extensions = append(parts.Extensions, strings.Join(subtags[i:j], "-"))
+			i = j
+		}
+	}
+	if next(func(s string) bool { return s == "x" }) {
+		if i+1 == len(subtags) {
+			return parts, invalid("LanguageTag", CodeBadFormat, offsets[i])
+		}
+		parts.PrivateUse = strings.Join(subtags[i:], "-")
+		i = len(subtags)
+	}
+	if i < len(subtags) {
+		return parts, invalid("LanguageTag", CodeBadFormat, offsets[i])
+	}
+	return parts, nil
+}
+
+// languageTagVariant check if the lower case subtag is a variant: 5 to 8
+// letters or digits, or a digit followed by 3 letters or digits.
+func languageTagVariant(s string) bool {
+	return len(s) >= 5 || (len(s) == 4 && s[0] >= '0' && s[0] <= '9')
+}
+
+// languageTagNumericRegion check if the string is a UN M.49 code usable as region subtag.
+func languageTagNumericRegion(str string) bool {
+	for _, code := range languageTagM49 {
+		if str == code {
+			return true
+		}
+	}
+	for _, entry := range ISO3166List {
+		if str == entry.Numeric {
+			return true
+		}
+	}
+	return false
+}
+
+func isAlnum(c byte) bool {
+	return ('0' <= c && c <= '9') || ('a' <= c && c <= 'z') || ('A' <= c && c <= 'Z')
+}
@@ -0,0 +1,152 @@
+package is
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestISO639(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param            string
+		iso1, iso2, iso3 bool
+	}{
+		{"", false, false, false},
+		{"en", true, false, false},
+		{"zh", true, false, false},
+		{"EN", false, false, false},
+		{"xx", false, false, false},
+		{"eng", false, true, true},
+		{"deu", false, true, true},
+		{"ger", false, true, false},
+		{"zho", false, true, true},
+		{"cmn", false, false, true},
+		{"yue", false, false, true},
+		{"afa", false, true, false},
+		{"qaa", false, true, true},
+		{"qtz", false, true, true},
+		{"qua", false, false, true},
+		{"und", false, true, true},
+		{"aaa", false, false, true},
+		{"zzj", false, false, true},
+		{"zzz", false, false, false},
+		{"engl", false, false, false},
+	}
+	for _, test := range tests {
+		if actual := ISO6391(test.param); actual != test.iso1 {
+			t.Errorf("Expected ISO6391(%q) to be %v, got %v", test.param, test.iso1, actual)
+		}
+		if actual := ISO6392(test.param); actual != test.iso2 {
+			t.Errorf("Expected ISO6392(%q) to be %v, got %v", test.param, test.iso2, actual)
+		}
+		if actual := ISO6393(test.param); actual != test.iso3 {
+			t.Errorf("Expected ISO6393(%q) to be %v, got %v", test.param, test.iso3, actual)
+		}
+	}
+}
+
+func TestLanguageTag(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"en", true},
+		{"en-GB", true},
+		{"EN-gb", true},
+		{"zh-Hant-TW", true},
+		{"sr-Latn-RS-u-ca-gregory", true},
+		{"es-419", true},
+		{"de-CH-1901", true},
+		{"sl-rozaj-biske", true},
+		{"ca-ES-valencia", true},
+		{"zh-yue-HK", true},
+		{"cmn-Hans-CN", true},
+		{"en-US-x-twain", true},
+		{"x-whatever", true},
+		{"i-klingon", true},
+		{"zh-min-nan", true},
+		{"en-a-bbb-x-a-ccc", true},
+		{"de-DE-u-co-phonebk", true},
+		{"en-XX", false},
+		{"en-999", false},
+		{"xx-GB", false},
+		{"ger-DE", false},
+		{"english", false},
+		{"en-", false},
+		{"-en", false},
+		{"en--GB", false},
+		{"en_GB", false},
+		{"de-419-DE", false},
+		{"a-DE", false},
+		{"ar-a-aaa-b-bbb-a-ccc", false},
+		{"de-1901-1901", false},
+		{"en-u", false},
+		{"en-x", false},
+		{"en-GB-Latn", false},
+		{"en-abcdefghi", false},
+	}
+	for _, test := range tests {
+		if actual := LanguageTag(test.param); actual != test.expected {
+			t.Errorf("Expected LanguageTag(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseLanguageTag(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected LanguageTagParts
+		tag      string
+	}{
+		{"EN-gb", LanguageTagParts{Language: "en", Region: "GB"}, "en-GB"},
+		{"zh-hant-tw", LanguageTagParts{Language: "zh", Script: "Hant", Region: "TW"}, "zh-Hant-TW"},
+		{"sr-Latn-RS-u-ca-gregory", LanguageTagParts{Language: "sr", Script: "Latn", Region: "RS", Extensions: []string{"u-ca-gregory"}}, "sr-Latn-RS-u-ca-gregory"},
+		{"zh-yue-HK", LanguageTagParts{Language: "zh", ExtLang: []string{"yue"}, Region: "HK"}, "zh-yue-HK"},
+		{"sl-IT-rozaj-biske-1994", LanguageTagParts{Language: "sl", Region: "IT", Variants: []string{"rozaj", "biske", "1994"}}, "sl-IT-rozaj-biske-1994"},
+		{"en-a-bbb-x-a-CCC", LanguageTagParts{Language: "en", Extensions: []string{"a-bbb"}, PrivateUse: "x-a-ccc"}, "en-a-bbb-x-a-ccc"},
+		{"X-Whatever", LanguageTagParts{PrivateUse: "x-whatever"}, "x-whatever"},
+		{"EN-gb-OED", LanguageTagParts{Language: "en-GB-oed", Grandfathered: true}, "en-GB-oed"},
+	}
+	for _, test := range tests {
+		actual, err := ParseLanguageTag(test.param)
+		if err != nil || !reflect.DeepEqual(actual, test.expected) || actual.String() != test.tag {
+			t.Errorf("Expected ParseLanguageTag(%q) to be %s %+v, got %s %+v, %v", test.param, test.tag, test.expected, actual, actual, err)
+		}
+	}
+}
+
+func TestParseLanguageTagErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"en_GB", CodeInvalidChar, 2},
+		{"en--GB", CodeBadFormat, 3},
+		{"en-GB-", CodeBadFormat, 6},
+		{"english", CodeBadFormat, 0},
+		{"xx-GB", CodeUnknownLanguage, 0},
+		{"zh-xyz", CodeUnknownLanguage, 3},
+		{"en-XX", CodeUnknownCountry, 3},
+		{"de-1901-1901", CodeBadFormat, 8},
+		{"en-u-ca-u-nu", CodeBadFormat, 8},
+		{"en-u-x-foo", CodeBadFormat, 3},
+		{"en-GB-Latn", CodeBadFormat, 6},
+	}
+	for _, test := range tests {
+		_, err := ParseLanguageTag(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "LanguageTag" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseLanguageTag(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
@@ -0,0 +1,282 @@
+package is
+
+import (
+	"strings"
+	"time"
+
+	"github.com/alioygur/is/checkdigit"
+)
+
+// nationalIDs maps ISO 3166 alpha-2 codes to the validators of NationalID.
+var nationalIDs = map[string]func(string) bool{
+	"BR": func(s string) bool { return CPF(s) || CNPJ(s) },
+	"CA": SIN,
+	"CN": CNResidentID,
+	"ES": func(s string) bool { return DNI(s) || NIE(s) },
+	"GB": NINO,
+	"IN": Aadhaar,
+	"IT": CodiceFiscale,
+	"TR": TCKN,
+	"US": SSN,
+}
+
+// NationalID check if the string is a national identification number of the
+// country with the given ISO 3166 alpha-2 code:
+//
+//	BR  CPF or CNPJ
+//	CA  SIN
+//	CN  CNResidentID
+//	ES  DNI or NIE
+//	GB  NINO
+//	IN  Aadhaar
+//	IT  CodiceFiscale
+//	TR  TCKN
+//	US  SSN
+//
+// It returns false for any other country.
+func NationalID(alpha2, str string) bool {
+	fn, ok := nationalIDs[alpha2]
+	return ok && fn(str)
+}
+
+// SIN check if the string is a Canadian Social Insurance Number: 9 digits,
+// optionally grouped as 130 692 544 or 130-692-544, with a Luhn check digit.
+// Numbers starting with 0 or 8 are not assigned.
+func SIN(str string) bool {
+	str = stripLayout(str, "xxx xxx xxx", "xxx-xxx-xxx")
+	if len(str) != 9 || !Numeric(str) || str[0] == '0' || str[0] == '8' {
+		return false
+	}
+	return checkdigit.Luhn{}.Validate(str)
+}
+
+// NINO check if the string is a UK National Insurance number such as
+// AB123456C or AB 12 34 56 C. The prefix may not use the letters D, F, I, Q,
+// U or V, nor O as its second letter, and the prefixes BG, GB, KN, NK, NT, TN
+// and ZZ are not allocated. The suffix is A, B, C or D.
+func NINO(str string) bool {
+	str = stripLayout(str, "xx xx xx xx x")
+	if len(str) != 9 || !Numeric(str[2:8]) {
+		return false
+	}
+	first, second, suffix := str[0], str[1], str[8]
+	if !strings.ContainsRune("ABCEGHJKLMNOPRSTWXYZ", rune(first)) ||
+		!strings.ContainsRune("ABCEGHJKLMNPRSTWXYZ", rune(second)) ||
+		suffix < 'A' || suffix > 'D' {
+		return false
+	}
+	switch str[:2] {
+	case "BG", "GB", "KN", "NK", "NT", "TN", "ZZ":
+		return false
+	}
+	return true
+}
+
+// Aadhaar check if the string is an Indian Aadhaar number: 12 digits,
+// optionally grouped as 2341 2341 2346, not starting with 0 or 1 and ending
+// with a Verhoeff check digit.
+func Aadhaar(str string) bool {
+	str = stripLayout(str, "xxxx xxxx xxxx", "xxxx-xxxx-xxxx")
+	if len(str) != 12 || !Numeric(str) || str[0] < '2' {
+		return false
+	}
+	return checkdigit.Verhoeff{}.Validate(str)
+}
+
+// CPF check if the string is a Brazilian individual taxpayer number (Cadastro
+// de Pessoas Físicas) of 11 digits, plain or written as 529.982.247-25, with
+// its two mod-11 check digits. Numbers of a single repeated digit are rejected.
+func CPF(str string) bool {
+	str = stripLayout(str, "xxx.xxx.xxx-xx")
+	if len(str) != 11 || !Numeric(str) || strings.Count(str, str[:1]) == len(str) {
+		return false
+	}
+	for n := 9; n <= 10; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			sum += int(str[i]-'0') * (n + 1 - i)
+		}
+		if int(str[n]-'0') != sum*10%11%10 {
+			return false
+		}
+	}
+	return true
+}
+
+// CNPJ check if the string is a Brazilian company taxpayer number (Cadastro
+// Nacional da Pessoa Jurídica) of 14 characters, plain or written as
+// 11.222.333/0001-81, with its two mod-11 check digits. The first 12
+// characters may be upper case letters, as in the alphanumeric CNPJ issued
+// from July 2026; the check digits are always digits.
+func CNPJ(str string) bool {
+	str = stripLayout(str, "xx.xxx.xxx/xxxx-xx")
+	if len(str) != 14 || !Numeric(str[12:]) || strings.Count(str, str[:1]) == len(str) {
+		return false
+	}
+	for i := 0; i < 12; i++ {
+		if c := str[i]; ('9' < c || c < '0') && ('Z' < c || c < 'A') {
+			return false
+		}
+	}
+	for n := 12; n <= 13; n++ {
+		sum := 0
+		for i := 0; i < n; i++ {
+			// weights run from 2 to 9 leftwards from the check digit
+			sum += int(str[i]-'0') * (2 + (n-1-i)%8)
+		}
+		check := 0
+		if r := sum % 11; r >= 2 {
+			check = 11 - r
+		}
+		if int(str[n]-'0') != check {
+			return false
+		}
+	}
+	return true
+}
+
+// dniLetters maps the remainder modulo 23 of a DNI number to its check letter.
+const dniLetters = "TRWAGMYFPDXBNJZSQVHLCKE"
+
+// DNI check if the string is a Spanish national identity document number:
+// 8 digits and a check letter, as in 12345678Z or 12345678-Z.
+func DNI(str string) bool {
+	str = stripLayout(str, "xxxxxxxx-x")
+	return len(str) == 9 && Numeric(str[:8]) && dniLetter(str[:8]) == str[8]
+}
+
+// NIE check if the string is a Spanish foreigner identity number: X, Y or Z,
+// 7 digits and a check letter, as in X1234567L or X-1234567-L.
+func NIE(str string) bool {
+	str = stripLayout(str, "x-xxxxxxx-x")
+	if len(str) != 9 || !Numeric(str[1:8]) {
+		return false
+	}
+	i := strings.IndexByte("XYZ", str[0])
+	return i >= 0 && dniLetter(string('0'+byte(i))+str[1:8]) == str[8]
+}
+
+func dniLetter(digits string) byte {
+	n := 0
+	for i := 0; i < len(digits); i++ {
+		n = (n*10 + int(digits[i]-'0')) % 23
+	}
+	return dniLetters[n]
+}
+
+// codiceFiscaleOdd holds the values of the characters 0-9 and A-Z at the odd
+// positions of a codice fiscale.
+var codiceFiscaleOdd = [36]int{
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21,
+	1, 0, 5, 7, 9, 13, 15, 17, 19, 21, 2, 4, 18, 20, 11, 3, 6, 8, 12, 14, 16, 10, 22, 25, 24, 23,
+}
+
+// CodiceFiscale check if the string is an Italian fiscal code for persons,
+// such as RSSMRA85T10A562S: 6 letters for the names, the birth year, month
+// letter and day (plus 40 for women), a cadastral code of the birth place and
+// a check letter. Digits replaced by the letters LMNPQRSTUV, used to tell
+// apart people who would otherwise share a code, are accepted.
+func CodiceFiscale(str string) bool {
+	if len(str) != 16 || !UpperCase(str) {
+		return false
+	}
+	// layout: 'A' letter, '9' digit or substitution letter, 'M' month letter
+	const layout = "AAAAAA99M99A999A"
+	var day int
+	sum := 0
+	for i := 0; i < 15; i++ {
+		c := str[i]
+		isDigit := '0' <= c && c <= '9'
+		if !isDigit && (c < 'A' || c > 'Z') {
+			return false
+		}
+		switch layout[i] {
+		case 'A':
+			if isDigit {
+				return false
+			}
+		case 'M':
+			if strings.IndexByte("ABCDEHLMPRST", c) < 0 {
+				return false
+			}
+		case '9':
+			if !isDigit {
+				d := strings.IndexByte("LMNPQRSTUV", c)
+				if d < 0 {
+					return false
+				}
+				c = '0' + byte(d)
+			}
+			if i == 9 || i == 10 {
+				day = day*10 + int(c-'0')
+			}
+		}
+		if ch := str[i]; i%2 == 0 {
+			if ch <= '9' {
+				sum += codiceFiscaleOdd[ch-'0']
+			} else {
+				sum += codiceFiscaleOdd[10+ch-'A']
+			}
+		} else if ch <= '9' {
+			sum += int(ch - '0')
+		} else {
+			sum += int(ch - 'A')
+		}
+	}
+	if day < 1 || (day > 31 && day < 41) || day > 71 {
+		return false
+	}
+	return str[15] == byte('A'+sum%26)
+}
+
+// CNResidentID check if the string is a Chinese resident identity card number
+// of 18 characters: a 6-digit administrative division code, the birth date
+// as YYYYMMDD, a 3-digit sequence number and an ISO 7064 MOD 11-2 check
+// character, which is a digit or X.
+func CNResidentID(str string) bool {
+	if len(str) != 18 || !Numeric(str[:17]) {
+		return false
+	}
+	switch str[:2] {
+	case "11", "12", "13", "14", "15", "21", "22", "23", "31", "32", "33", "34", "35", "36", "37",
+		"41", "42", "43", "44", "45", "46", "50", "51", "52", "53", "54", "61", "62", "63", "64", "65",
+		"71", "81", "82":
+	default:
+		return false
+	}
+	if _, err := time.Parse("20060102", str[6:14]); err != nil {
+		return false
+	}
+	check := str[17]
+	if check == 'x' {
+		check = 'X'
+	}
+	c, _ := checkdigit.Mod11Radix2{}.Compute(str[:17])
+	return c[0] == check
+}
+
+// stripLayout removes the separators from str if it is written in one of the
+// layouts, in which 'x' stands for any character and anything else for a
+// separator. Otherwise str is returned unchanged.
+func stripLayout(str string, layouts ...string) string {
+	for _, layout := range layouts {
+		if len(str) != len(layout) {
+			continue
+		}
+		match := true
+		for i := 0; i < len(layout) && match; i++ {
+			match = layout[i] == 'x' || layout[i] == str[i]
+		}
+		if !match {
+			continue
+		}
+		var b strings.Builder
+		for i := 0; i < len(layout); i++ {
+			if layout[i] == 'x' {
+				b.WriteByte(str[i])
+			}
+		}
+		return b.String()
+	}
+	return str
+}
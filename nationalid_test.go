@@ -0,0 +1,127 @@
+package is
+
+import "testing"
+
+func TestNationalID(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		country  string
+		param    string
+		expected bool
+	}{
+		{"US", "191-60-2869", true},
+		{"US", "666-60-2869", false},
+		{"CA", "130 692 544", true},
+		{"GB", "AB 12 34 56 C", true},
+		{"IN", "2341 2341 2346", true},
+		{"BR", "529.982.247-25", true},
+		{"BR", "11.222.333/0001-81", true},
+		{"ES", "12345678Z", true},
+		{"ES", "X1234567L", true},
+		{"IT", "RSSMRA85T10A562S", true},
+		{"CN", "11010519491231002X", true},
+		{"TR", "10000000146", true},
+		{"DE", "191-60-2869", false},
+		{"us", "191-60-2869", false},
+		{"", "", false},
+	}
+	for _, test := range tests {
+		if actual := NationalID(test.country, test.param); actual != test.expected {
+			t.Errorf("Expected NationalID(%q, %q) to be %v, got %v", test.country, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestNationalIDValidators(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		name     string
+		fn       func(string) bool
+		param    string
+		expected bool
+	}{
+		{"SIN", SIN, "130692544", true},
+		{"SIN", SIN, "130-692-544", true},
+		{"SIN", SIN, "130 692 545", false},
+		{"SIN", SIN, "130-692 544", false},
+		{"SIN", SIN, "046454286", false},
+		{"SIN", SIN, "800000002", false},
+		{"SIN", SIN, "13069254", false},
+
+		{"NINO", NINO, "AB123456C", true},
+		{"NINO", NINO, "AB 12 34 56 C", true},
+		{"NINO", NINO, "CE123456D", true},
+		{"NINO", NINO, "AB123456E", false},
+		{"NINO", NINO, "AB123456", false},
+		{"NINO", NINO, "QQ123456C", false},
+		{"NINO", NINO, "DA123456C", false},
+		{"NINO", NINO, "AO123456C", false},
+		{"NINO", NINO, "GB123456C", false},
+		{"NINO", NINO, "ZZ123456C", false},
+		{"NINO", NINO, "ab123456c", false},
+		{"NINO", NINO, "AB12345XC", false},
+
+		{"Aadhaar", Aadhaar, "234123412346", true},
+		{"Aadhaar", Aadhaar, "2341 2341 2346", true},
+		{"Aadhaar", Aadhaar, "499187324658", true},
+		{"Aadhaar", Aadhaar, "234123412347", false},
+		{"Aadhaar", Aadhaar, "134123412346", false},
+		{"Aadhaar", Aadhaar, "23412341234", false},
+
+		{"CPF", CPF, "52998224725", true},
+		{"CPF", CPF, "529.982.247-25", true},
+		{"CPF", CPF, "529.982.247-26", false},
+		{"CPF", CPF, "52998224715", false},
+		{"CPF", CPF, "111.111.111-11", false},
+		{"CPF", CPF, "529982247-25", false},
+
+		{"CNPJ", CNPJ, "11222333000181", true},
+		{"CNPJ", CNPJ, "11.222.333/0001-81", true},
+		{"CNPJ", CNPJ, "12.ABC.345/01DE-35", true},
+		{"CNPJ", CNPJ, "12ABC34501DE35", true},
+		{"CNPJ", CNPJ, "12ABC34501DE36", false},
+		{"CNPJ", CNPJ, "12abc34501de35", false},
+		{"CNPJ", CNPJ, "11222333000182", false},
+		{"CNPJ", CNPJ, "00000000000000", false},
+		{"CNPJ", CNPJ, "1122233300018", false},
+
+		{"DNI", DNI, "12345678Z", true},
+		{"DNI", DNI, "12345678-Z", true},
+		{"DNI", DNI, "12345678A", false},
+		{"DNI", DNI, "1234567Z", false},
+		{"DNI", DNI, "X1234567L", false},
+
+		{"NIE", NIE, "X1234567L", true},
+		{"NIE", NIE, "X-1234567-L", true},
+		{"NIE", NIE, "Y1234567X", true},
+		{"NIE", NIE, "Y1234567L", false},
+		{"NIE", NIE, "A1234567L", false},
+		{"NIE", NIE, "12345678Z", false},
+
+		{"CodiceFiscale", CodiceFiscale, "RSSMRA85T10A562S", true},
+		{"CodiceFiscale", CodiceFiscale, "BNCGLI80A41H501C", true},
+		{"CodiceFiscale", CodiceFiscale, "RSSMRA85T10A562T", false},
+		{"CodiceFiscale", CodiceFiscale, "rssmra85t10a562s", false},
+		{"CodiceFiscale", CodiceFiscale, "RSSMRA85T10A56", false},
+		{"CodiceFiscale", CodiceFiscale, "RSSMRA85F10A562S", false},
+		{"CodiceFiscale", CodiceFiscale, "RSSMRA85T35A562S", false},
+		{"CodiceFiscale", CodiceFiscale, "RSSMRA85T10A56NH", true},
+		{"CodiceFiscale", CodiceFiscale, "RSSMRA85T10A56KH", false},
+		{"CodiceFiscale", CodiceFiscale, "1SSMRA85T10A562S", false},
+
+		{"CNResidentID", CNResidentID, "11010519491231002X", true},
+		{"CNResidentID", CNResidentID, "11010519491231002x", true},
+		{"CNResidentID", CNResidentID, "440307199003071239", true},
+		{"CNResidentID", CNResidentID, "110105194912310021", false},
+		{"CNResidentID", CNResidentID, "11010519491232002X", false},
+		{"CNResidentID", CNResidentID, "99010519491231002X", false},
+		{"CNResidentID", CNResidentID, "11010519491231002", false},
+	}
+	for _, test := range tests {
+		if actual := test.fn(test.param); actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,182 @@
+package is
+
+import (
+	"bytes"
+	"fmt"
+	"net"
+	"sort"
+	"strings"
+)
+
+// CIDR check if the string is an IPv4 or IPv6 network in CIDR notation, e.g. 10.0.0.0/8.
+// Host bits may be set, as in 10.1.2.3/8; use CIDRStrict to reject them.
+func CIDR(str string) bool {
+	_, _, err := net.ParseCIDR(str)
+	return err == nil
+}
+
+// CIDRv4 check if the string is an IPv4 network in CIDR notation.
+func CIDRv4(str string) bool {
+	return CIDR(str) && !strings.Contains(str, ":")
+}
+
+// CIDRv6 check if the string is an IPv6 network in CIDR notation.
+func CIDRv6(str string) bool {
+	return CIDR(str) && strings.Contains(str, ":")
+}
+
+// CIDRStrict check if the string is a network in CIDR notation whose host bits
+// are all zero, so 10.0.0.0/8 is valid but 10.1.2.3/8 is not.
+func CIDRStrict(str string) bool {
+	ip, n, err := net.ParseCIDR(str)
+	return err == nil && ip.Equal(n.IP)
+}
+
+// IPRange check if the string is an inclusive range of IP addresses of the same
+// version, written as first-last, e.g. 192.168.1.10-192.168.1.50.
+func IPRange(str string) bool {
+	_, _, ok := parseIPRange(str)
+	return ok
+}
+
+// IPInNetworks check if the IP address ip belongs to any of the given CIDR networks.
+// Invalid networks are ignored. To test many addresses against the same networks,
+// build a NetworkSet instead.
+func IPInNetworks(ip string, cidrs ...string) bool {
+	addr := net.ParseIP(ip)
+	if addr == nil {
+		return false
+	}
+	for _, c := range cidrs {
+		if _, n, err := net.ParseCIDR(c); err == nil && n.Contains(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// NetworkSet is a precompiled set of IP networks. Membership tests take
+// logarithmic time in the number of networks. A NetworkSet is safe for
+// concurrent use.
+type NetworkSet struct {
+	// v4 and v6 are sorted and do not overlap. As with net.IPNet, IPv4
+	// addresses, which are stored in their 16-byte IPv4-mapped form, only
+	// belong to IPv4 networks.
+	v4, v6 []ipInterval
+}
+
+type ipInterval struct {
+	first, last [net.IPv6len]byte
+}
+
+// NewNetworkSet compiles networks into a NetworkSet. Each network is a CIDR
+// (10.0.0.0/8), a single address (192.0.2.1) or a range accepted by IPRange.
+// As with IPInNetworks, IPv4 addresses only belong to IPv4 networks, so ::/0
+// does not contain 192.0.2.1.
+func NewNetworkSet(networks ...string) (*NetworkSet, error) {
+	var v4, v6 []ipInterval
+	for _, s := range networks {
+		var r ipInterval
+		var isV4 bool
+		switch {
+		case strings.Contains(s, "/"):
+			_, n, err := net.ParseCIDR(s)
+			if err != nil {
+				return nil, fmt.Errorf("is: invalid network %q", s)
+			}
+			first, mask := n.IP.To16(), n.Mask
+			isV4 = n.IP.To4() != nil
+			if isV4 {
+				// the mask of an IPv4-mapped network such as ::ffff:0:0/96
+				// applies to the IPv4 part only, as in net.IPNet
+				mask = append(net.CIDRMask(96, 128)[:12], mask[len(mask)-net.IPv4len:]...)
+			}
+			copy(r.first[:], first)
+			for i := range r.last {
+				r.last[i] = first[i] | ^mask[i]
+			}
+		case strings.Contains(s, "-"):
+			first, last, ok := parseIPRange(s)
+			if !ok {
+				return nil, fmt.Errorf("is: invalid network %q", s)
+			}
+			isV4 = first.To4() != nil
+			if isV4 != (last.To4() != nil) {
+				return nil, fmt.Errorf("is: invalid network %q", s)
+			}
+			copy(r.first[:], first.To16())
+			copy(r.last[:], last.To16())
+		default:
+			ip := net.ParseIP(s)
+			if ip == nil {
+				return nil, fmt.Errorf("is: invalid network %q", s)
+			}
+			isV4 = ip.To4() != nil
+			copy(r.first[:], ip.To16())
+			r.last = r.first
+		}
+		if isV4 {
+			v4 = append(v4, r)
+		} else {
+			v6 = append(v6, r)
+		}
+	}
+	return &NetworkSet{v4: mergeIntervals(v4), v6: mergeIntervals(v6)}, nil
+}
+
+// mergeIntervals sorts ranges and merges the ones that overlap, in place.
+func mergeIntervals(ranges []ipInterval) []ipInterval {
+	sort.Slice(ranges, func(i, j int) bool {
+		return bytes.Compare(ranges[i].first[:], ranges[j].first[:]) < 0
+	})
+	merged := ranges[:0]
+	for _, r := range ranges {
+		if n := len(merged); n > 0 && bytes.Compare(r.first[:], merged[n-1].last[:]) <= 0 {
+			if bytes.Compare(r.last[:], merged[n-1].last[:]) > 0 {
+				merged[n-1].last = r.last
+			}
+			continue
+		}
+		merged = append(merged, r)
+	}
+	return merged
+}
+
+// Contains check if the IP address ip belongs to one of the networks of the set.
+func (s *NetworkSet) Contains(ip string) bool {
+	return s.ContainsIP(net.ParseIP(ip))
+}
+
+// ContainsIP is like Contains for a parsed address. It returns false for a nil ip.
+func (s *NetworkSet) ContainsIP(ip net.IP) bool {
+	ranges := s.v6
+	if ip.To4() != nil {
+		ranges = s.v4
+	}
+	ip = ip.To16()
+	if ip == nil {
+		return false
+	}
+	// index of the first range that starts after ip
+	i := sort.Search(len(ranges), func(i int) bool {
+		return bytes.Compare(ranges[i].first[:], ip) > 0
+	})
+	return i > 0 && bytes.Compare(ip, ranges[i-1].last[:]) <= 0
+}
+
+// parseIPRange parses first-last into two addresses of the same version with first <= last.
+func parseIPRange(str string) (first, last net.IP, ok bool) {
+	i := strings.IndexByte(str, '-')
+	if i < 0 {
+		return nil, nil, false
+	}
+	a, b := strings.TrimSpace(str[:i]), strings.TrimSpace(str[i+1:])
+	first, last = net.ParseIP(a), net.ParseIP(b)
+	if first == nil || last == nil || strings.Contains(a, ":") != strings.Contains(b, ":") {
+		return nil, nil, false
+	}
+	if bytes.Compare(first.To16(), last.To16()) > 0 {
+		return nil, nil, false
+	}
+	return first, last, true
+}
@@ -0,0 +1,167 @@
+package is
+
+import "testing"
+
+func TestCIDR(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		cidr   bool
+		v4     bool
+		v6     bool
+		strict bool
+	}{
+		{"", false, false, false, false},
+		{"10.0.0.0", false, false, false, false},
+		{"10.0.0.0/8", true, true, false, true},
+		{"10.1.2.3/8", true, true, false, false},
+		{"0.0.0.0/0", true, true, false, true},
+		{"192.168.1.0/33", false, false, false, false},
+		{"300.0.0.0/8", false, false, false, false},
+		{"2001:db8::/48", true, false, true, true},
+		{"2001:db8::1/48", true, false, true, false},
+		{"::/0", true, false, true, true},
+		{"2001:db8::/129", false, false, false, false},
+		{"::ffff:10.0.0.0/104", true, false, true, true},
+		{"10.0.0.0/8/8", false, false, false, false},
+	}
+	for _, test := range tests {
+		if actual := CIDR(test.param); actual != test.cidr {
+			t.Errorf("Expected CIDR(%q) to be %v, got %v", test.param, test.cidr, actual)
+		}
+		if actual := CIDRv4(test.param); actual != test.v4 {
+			t.Errorf("Expected CIDRv4(%q) to be %v, got %v", test.param, test.v4, actual)
+		}
+		if actual := CIDRv6(test.param); actual != test.v6 {
+			t.Errorf("Expected CIDRv6(%q) to be %v, got %v", test.param, test.v6, actual)
+		}
+		if actual := CIDRStrict(test.param); actual != test.strict {
+			t.Errorf("Expected CIDRStrict(%q) to be %v, got %v", test.param, test.strict, actual)
+		}
+	}
+}
+
+func TestIPRange(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"192.168.1.10", false},
+		{"192.168.1.10-192.168.1.50", true},
+		{"192.168.1.10 - 192.168.1.50", true},
+		{"192.168.1.10-192.168.1.10", true},
+		{"192.168.1.50-192.168.1.10", false},
+		{"192.168.1.10-", false},
+		{"192.168.1.10-2001:db8::1", false},
+		{"2001:db8::1-2001:db8::ff", true},
+		{"2001:db8::ff-2001:db8::1", false},
+		{"192.168.1.10-192.168.1.300", false},
+	}
+	for _, test := range tests {
+		actual := IPRange(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected IPRange(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestIPInNetworks(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		ip       string
+		cidrs    []string
+		expected bool
+	}{
+		{"10.1.2.3", []string{"10.0.0.0/8"}, true},
+		{"10.1.2.3", []string{"192.168.0.0/16", "10.0.0.0/8"}, true},
+		{"11.1.2.3", []string{"10.0.0.0/8"}, false},
+		{"10.1.2.3", []string{"invalid", "10.0.0.0/8"}, true},
+		{"10.1.2.3", nil, false},
+		{"2001:db8::1", []string{"2001:db8::/32"}, true},
+		{"2001:db9::1", []string{"2001:db8::/32"}, false},
+		{"invalid", []string{"0.0.0.0/0"}, false},
+	}
+	for _, test := range tests {
+		actual := IPInNetworks(test.ip, test.cidrs...)
+		if actual != test.expected {
+			t.Errorf("Expected IPInNetworks(%q, %q) to be %v, got %v", test.ip, test.cidrs, test.expected, actual)
+		}
+	}
+}
+
+func TestNetworkSet(t *testing.T) {
+	t.Parallel()
+
+	set, err := NewNetworkSet(
+		"10.0.0.0/8",
+		"10.1.0.0/16", // contained in 10.0.0.0/8
+		"192.168.1.10-192.168.1.50",
+		"192.168.1.40-192.168.1.60", // overlaps the range above
+		"203.0.113.7",
+		"2001:db8::/48",
+		"fe80::1",
+	)
+	if err != nil {
+		t.Fatalf("NewNetworkSet failed: %v", err)
+	}
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"invalid", false},
+		{"9.255.255.255", false},
+		{"10.0.0.0", true},
+		{"10.255.255.255", true},
+		{"11.0.0.0", false},
+		{"192.168.1.9", false},
+		{"192.168.1.10", true},
+		{"192.168.1.55", true},
+		{"192.168.1.60", true},
+		{"192.168.1.61", false},
+		{"203.0.113.7", true},
+		{"203.0.113.8", false},
+		{"::ffff:10.0.0.1", true},
+		{"2001:db8::1", true},
+		{"2001:db8:0:ffff::1", true},
+		{"2001:db8:1::1", false},
+		{"fe80::1", true},
+		{"fe80::2", false},
+	}
+	for _, test := range tests {
+		actual := set.Contains(test.param)
+		if actual != test.expected {
+			t.Errorf("Expected NetworkSet.Contains(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+
+	for _, invalid := range []string{"10.0.0.0/33", "10.0.0.10-10.0.0.1", "::1-::ffff:1.2.3.4", "foo"} {
+		if _, err := NewNetworkSet(invalid); err == nil {
+			t.Errorf("Expected NewNetworkSet(%q) to fail", invalid)
+		}
+	}
+}
+
+func TestNetworkSetMatchesIPInNetworks(t *testing.T) {
+	t.Parallel()
+
+	networks := []string{"::/0", "0.0.0.0/0", "::ffff:0:0/96", "::ffff:10.0.0.0/104", "10.0.0.0/8", "2001:db8::/32", "::/96"}
+	addrs := []string{"1.2.3.4", "10.0.0.1", "::ffff:1.2.3.4", "::1", "::", "0.0.0.0", "2001:db8::1", "::1.2.3.4"}
+	for _, n := range networks {
+		set, err := NewNetworkSet(n)
+		if err != nil {
+			t.Fatalf("NewNetworkSet(%q) failed: %v", n, err)
+		}
+		for _, a := range addrs {
+			if expected, actual := IPInNetworks(a, n), set.Contains(a); actual != expected {
+				t.Errorf("Expected NewNetworkSet(%q).Contains(%q) to be %v, got %v", n, a, expected, actual)
+			}
+		}
+	}
+}
@@ -0,0 +1,336 @@
+package is
+
+import "strings"
+
+// PhoneType is the kind of line a phone number belongs to.
+type PhoneType int
+
+// Phone number types reported by ParsePhone.
+const (
+	// PhoneUnknown is a number whose type the numbering plan does not tell,
+	// such as a toll-free or premium-rate number.
+	PhoneUnknown PhoneType = iota
+	// PhoneFixedLine is a geographic number.
+	PhoneFixedLine
+	// PhoneMobile is a mobile number.
+	PhoneMobile
+	// PhoneFixedLineOrMobile is a number of a plan that does not distinguish
+	// fixed-line from mobile numbers, such as the North American plan.
+	PhoneFixedLineOrMobile
+)
+
+var phoneTypeNames = []string{"unknown", "fixed-line", "mobile", "fixed-line-or-mobile"}
+
+// String returns the name of the type, e.g. "mobile".
+func (t PhoneType) String() string {
+	if t < 0 || int(t) >= len(phoneTypeNames) {
+		return phoneTypeNames[PhoneUnknown]
+	}
+	return phoneTypeNames[t]
+}
+
+// PhonePlanEntry stores the numbering plan of a country
+type PhonePlanEntry struct {
+	// Region is the Alpha2Code of the country in ISO3166List.
+	Region string
+	// CallingCode is the ITU-T E.164 country calling code, without "+".
+	CallingCode string
+	// Trunk is the prefix dialled before national numbers within the
+	// country, e.g. "0", or empty if the plan has none.
+	Trunk string
+	// MinLength and MaxLength bound the number of digits of the national
+	// significant number, which excludes the calling code and trunk prefix.
+	MinLength, MaxLength int
+	// Leading lists the prefixes a national significant number may start
+	// with, and Fixed and Mobile the prefixes of fixed-line and mobile
+	// numbers. Prefixes are separated by commas; "71-75" is a range of
+	// prefixes of the same length and "x" matches any digit.
+	Leading, Fixed, Mobile string
+}
+
+// phoneCanada lists the Canadian area codes of the North American Numbering Plan.
+const phoneCanada = "204,226,236,249,250,257,263,289,306,343,354,365,367,368,382,387,403,416,418,428,431," +
+	"437,438,450,460,468,474,506,514,519,548,579,581,584,587,604,613,639,647,672,683,705,709,742," +
+	"753,778,780,782,807,819,825,867,873,879,902,905,942"
+
+// PhonePlanList based on the national numbering plans published by the ITU-T
+// https://www.itu.int/oth/T0202.aspx?parent=T0202
+var PhonePlanList = []PhonePlanEntry{
+	{"AE", "971", "0", 8, 9, "2-7,9", "2-4,6,7,9", "5"},
+	{"AT", "43", "0", 4, 13, "1-9", "1-5,7", "6"},
+	{"AU", "61", "0", 9, 9, "1-5,7,8", "2,3,7,8", "4"},
+	{"BE", "32", "0", 8, 9, "1-9", "1-3,5-9", "4"},
+	{"BR", "55", "0", 10, 11, "1-9", "xx2,xx3,xx4,xx5", "xx9"},
+	{"CA", "1", "1", 10, 10, phoneCanada, phoneCanada, phoneCanada},
+	{"CH", "41", "0", 9, 9, "2-9", "2-6,91", "75-79"},
+	{"CL", "56", "", 9, 9, "2-9", "2-8", "9"},
+	{"CN", "86", "0", 10, 11, "1-9", "10,2-9", "13-19"},
+	{"CO", "57", "", 10, 10, "1,3,60", "60", "3"},
+	{"CZ", "420", "", 9, 9, "2-9", "2-5", "60,70,72,73,77,79"},
+	{"DE", "49", "0", 5, 13, "1-9", "2-9", "15-17"},
+	{"DK", "45", "", 8, 8, "2-9", "2-9", "2-9"},
+	{"EG", "20", "0", 8, 10, "1-9", "2-9", "10-12,15"},
+	{"ES", "34", "", 9, 9, "5-9", "81-88,91-98", "6,7"},
+	{"FI", "358", "0", 5, 12, "1-9", "13-19,2,3,5,6,8,9", "4,50"},
+	{"FR", "33", "0", 9, 9, "1-9", "1-5", "6,73-79"},
+	{"GB", "44", "0", 9, 10, "1-3,5,7-9", "1,2", "71-75,77-79"},
+	{"GR", "30", "", 10, 10, "2,5-9", "2", "69"},
+	{"HK", "852", "", 8, 8, "2-9", "2,3", "5,6,9"},
+	{"HU", "36", "06", 8, 9, "1-9", "1-9", "20,30,31,50,70"},
+	{"ID", "62", "0", 7, 12, "2-9", "2-7,9", "8"},
+	{"IE", "353", "0", 7, 9, "1-9", "1,2,4-7,9", "83,85-87,89"},
+	{"IL", "972", "0", 8, 9, "2-9", "2-4,8,9", "5"},
+	{"IN", "91", "0", 10, 10, "1-9", "1-5", "6-9"},
+	// Italian fixed-line numbers keep their leading 0 after the calling code.
+	{"IT", "39", "", 6, 11, "0,3,8", "0", "3"},
+	{"JP", "81", "0", 9, 10, "1-9", "1-9", "70,80,90"},
+	{"KR", "82", "0", 8, 10, "1-6", "2-6", "10"},
+	{"KZ", "7", "8", 10, 10, "6,7", "71,72", "70,747,75-77"},
+	{"MX", "52", "", 10, 10, "2-9", "2-9", "2-9"},
+	{"MY", "60", "0", 8, 10, "1-9", "3-9", "1"},
+	{"NG", "234", "0", 8, 10, "1-9", "1-9", "70,80,81,90,91"},
+	{"NL", "31", "0", 9, 9, "1-7", "1-5,7", "6"},
+	{"NO", "47", "", 8, 8, "2-9", "2,3,5-7", "4,9"},
+	{"NZ", "64", "0", 8, 10, "2-9", "3,4,6,7,9", "2"},
+	{"PE", "51", "0", 8, 9, "1-9", "1-8", "9"},
+	{"PH", "63", "0", 8, 10, "2-9", "2-8", "9"},
+	{"PK", "92", "0", 9, 10, "2-9", "2,4-9", "3"},
+	{"PL", "48", "", 9, 9, "1-9", "1-4,52,54-56,58,59,61-63,65,67,68,71,74-77,81-87,89,91,94,95", "45,50,51,53,57,60,66,69,72,73,78,79,88"},
+	{"PT", "351", "", 9, 9, "2,7-9", "2", "91-93,96"},
+	{"RO", "40", "0", 9, 9, "2,3,7-9", "2,3", "7"},
+	{"RU", "7", "8", 10, 10, "3,4,8,9", "3,4,8", "9"},
+	{"SA", "966", "0", 8, 9, "1,5,8,9", "1", "5"},
+	{"SE", "46", "0", 7, 10, "1-9", "1-6,8,9", "70,72,73,76,79"},
+	{"SG", "65", "", 8, 8, "3,6,8,9", "6", "8,9"},
+	{"TH", "66", "0", 8, 9, "2-9", "2-5,7", "6,8,9"},
+	{"TR", "90", "0", 10, 10, "2-5,8,9", "2-4", "5"},
+	{"UA", "380", "0", 9, 9, "3-9", "3-6", "39,50,63,66-68,73,9"},
+	{"US", "1", "1", 10, 10, "2-9", "2-9", "2-9"},
+	{"VN", "84", "0", 9, 10, "2,3,5,7-9", "2", "3,5,7-9"},
+	{"ZA", "27", "0", 9, 9, "1-8", "1-5", "6,7,81-84"},
+}
+
+// phoneMaxDigits is the longest number allowed by E.164, calling code included.
+const phoneMaxDigits = 15
+
+// PhoneNumber is a phone number split into its components.
+type PhoneNumber struct {
+	// CountryCode is the country calling code, e.g. "44".
+	CountryCode string
+	// Region is the ISO 3166 alpha-2 code of the country, e.g. "GB".
+	Region string
+	// National is the national significant number, without trunk prefix.
+	National string
+	// Type is the kind of line, as far as the plan tells.
+	Type PhoneType
+}
+
+// String returns the number in E.164 format, e.g. +442079460018.
+func (p PhoneNumber) String() string {
+	return "+" + p.CountryCode + p.National
+}
+
+// PhoneE164 check if the string is a phone number in E.164 format: a "+"
+// followed by the country calling code and national number, without any
+// separators, e.g. +14155552671. The number must match the country's entry
+// in PhonePlanList.
+func PhoneE164(str string) bool {
+	if len(str) < 2 || str[0] != '+' || !Numeric(str[1:]) {
+		return false
+	}
+	_, err := ParsePhone(str, "")
+	return err == nil
+}
+
+// Phone check if the string is a valid phone number. Numbers starting with
+// "+" are read in international format; any other number is read in the
+// national format of region, the Alpha2Code of a country in PhonePlanList.
+// Spaces, hyphens, dots, slashes and parentheses are ignored.
+func Phone(str, region string) bool {
+	_, err := ParsePhone(str, region)
+	return err == nil
+}
+
+// ParsePhone validates a phone number like Phone and returns it normalized.
+// The returned Region is that of the number, which differs from region when
+// countries share a calling code, as the United States and Canada do. On
+// failure the error is a *ValidationError.
+func ParsePhone(str, region string) (PhoneNumber, error) {
+	var num PhoneNumber
+	if str == "" {
+		return num, invalid("Phone", CodeEmpty, -1)
+	}
+	// digits of str and the offset in str of each of them; the spare two
+	// digits make room for a trunk prefix
+	var buf [phoneMaxDigits + 2]byte
+	var pos [phoneMaxDigits + 2]int
+	n, first := 0, 0
+	intl := str[0] == '+'
+	if intl {
+		first = 1
+	}
+	for i := first; i < len(str); i++ {
+		switch c := str[i]; {
+		case '0' <= c && c <= '9':
+			if n == len(buf) {
+				return num, invalid("Phone", CodeTooLong, i)
+			}
+			buf[n], pos[n] = c, i
+			n++
+		case c == ' ' || c == '-' || c == '.' || c == '/' || c == '(' || c == ')':
+		default:
+			return num, invalid("Phone", CodeInvalidChar, i)
+		}
+	}
+	if n == 0 {
+		return num, invalid("Phone", CodeTooShort, -1)
+	}
+	digits := string(buf[:n])
+
+	var plan *phonePlan
+	var nsn string
+	if intl {
+		for l := 1; l <= 3 && l < len(digits); l++ {
+			if p := phonePlanFor(digits[:l], digits[l:]); p != nil {
+				plan, nsn = p, digits[l:]
+				break
+			}
+		}
+		if plan == nil {
+			return num, invalid("Phone", CodeUnknownCountry, 1)
+		}
+	} else {
+		var home *PhonePlanEntry
+		for i := range PhonePlanList {
+			if strings.EqualFold(PhonePlanList[i].Region, region) {
+				home = &PhonePlanList[i]
+				break
+			}
+		}
+		if home == nil {
+			return num, invalid("Phone", CodeUnknownCountry, -1)
+		}
+		nsn = digits
+		if t := home.Trunk; t != "" && strings.HasPrefix(nsn, t) && len(nsn)-len(t) >= home.MinLength {
+			nsn = nsn[len(t):]
+		}
+		plan = phonePlanFor(home.CallingCode, nsn)
+	}
+
+	e := plan.entry
+	start := n - len(nsn)
+	switch {
+	case len(nsn) < e.MinLength:
+		return num, invalid("Phone", CodeTooShort, -1)
+	case len(nsn) > e.MaxLength:
+		return num, invalid("Phone", CodeTooLong, pos[start+e.MaxLength])
+	case len(e.CallingCode)+len(nsn) > phoneMaxDigits:
+		return num, invalid("Phone", CodeTooLong, pos[start+phoneMaxDigits-len(e.CallingCode)])
+	case matchPhonePrefix(plan.leading, nsn) == 0:
+		return num, invalid("Phone", CodeBadFormat, pos[start])
+	}
+
+	num = PhoneNumber{CountryCode: e.CallingCode, Region: e.Region, National: nsn}
+	switch fixed, mobile := matchPhonePrefix(plan.fixed, nsn), matchPhonePrefix(plan.mobile, nsn); {
+	case fixed == 0 && mobile == 0:
+		num.Type = PhoneUnknown
+	case fixed > mobile:
+		num.Type = PhoneFixedLine
+	case mobile > fixed:
+		num.Type = PhoneMobile
+	default:
+		num.Type = PhoneFixedLineOrMobile
+	}
+	return num, nil
+}
+
+// phonePrefix is a range of prefixes of the same length; low may contain
+// 'x' wildcards, in which case high equals low.
+type phonePrefix struct {
+	low, high string
+}
+
+// phonePlan is a PhonePlanEntry with its prefix lists parsed.
+type phonePlan struct {
+	entry                  *PhonePlanEntry
+	leading, fixed, mobile []phonePrefix
+}
+
+// phonePlans holds the parsed plans of PhonePlanList, in the same order.
+var phonePlans = parsePhonePlanList()
+
+func parsePhonePlanList() []phonePlan {
+	plans := make([]phonePlan, len(PhonePlanList))
+	for i := range PhonePlanList {
+		e := &PhonePlanList[i]
+		plans[i] = phonePlan{e, parsePhonePrefixes(e.Leading), parsePhonePrefixes(e.Fixed), parsePhonePrefixes(e.Mobile)}
+	}
+	return plans
+}
+
+func parsePhonePrefixes(list string) []phonePrefix {
+	if list == "" {
+		return nil
+	}
+	var prefixes []phonePrefix
+	for _, s := range strings.Split(list, ",") {
+		p := phonePrefix{s, s}
+		if i := strings.IndexByte(s, '-'); i >= 0 {
+			p = phonePrefix{s[:i], s[i+1:]}
+			if len(p.low) != len(p.high) || p.low > p.high || !Numeric(p.low) || !Numeric(p.high) {
+				panic("is: bad PhonePlanList prefix " + s)
+			}
+		}
+		if p.low == "" || strings.Trim(p.low, "0123456789x") != "" {
+			panic("is: bad PhonePlanList prefix " + s)
+		}
+		prefixes = append(prefixes, p)
+	}
+	return prefixes
+}
+
+// phonePlanFor returns the plan of the calling code whose leading prefixes
+// best match the national number, or nil if no plan has the calling code.
+func phonePlanFor(callingCode, nsn string) *phonePlan {
+	var best *phonePlan
+	bestLen := -1
+	for i := range phonePlans {
+		p := &phonePlans[i]
+		if p.entry.CallingCode != callingCode {
+			continue
+		}
+		if l := matchPhonePrefix(p.leading, nsn); l > bestLen {
+			best, bestLen = p, l
+		}
+	}
+	return best
+}
+
+// matchPhonePrefix returns the length of the longest prefix of the list that
+// nsn starts with, or 0 if there is none.
+func matchPhonePrefix(prefixes []phonePrefix, nsn string) int {
+	longest := 0
+	for _, p := range prefixes {
+		n := len(p.low)
+		if n > len(nsn) || n <= longest {
+			continue
+		}
+		s := nsn[:n]
+		if p.low == p.high {
+			ok := true
+			for i := 0; i < n; i++ {
+				if p.low[i] != 'x' && p.low[i] != s[i] {
+					ok = false
+					break
+				}
+			}
+			if !ok {
+				continue
+			}
+		} else if s < p.low || s > p.high {
+			continue
+		}
+		longest = n
+	}
+	return longest
+}
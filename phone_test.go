@@ -0,0 +1,155 @@
+package is
+
+import "testing"
+
+func TestPhonePlanList(t *testing.T) {
+	t.Parallel()
+
+	for _, e := range PhonePlanList {
+		if !ISO3166Alpha2(e.Region) {
+			t.Errorf("PhonePlanList entry %s is not in ISO3166List", e.Region)
+		}
+		if e.MinLength > e.MaxLength || len(e.CallingCode)+e.MaxLength > phoneMaxDigits {
+			t.Errorf("PhonePlanList entry %s has bad lengths %d-%d", e.Region, e.MinLength, e.MaxLength)
+		}
+	}
+}
+
+func TestPhoneE164(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"+", false},
+		{"+14155552671", true},
+		{"+442079460018", true},
+		{"+390612345678", true},
+		{"+5511912345678", true},
+		{"+1 415 555 2671", false},
+		{"+1-415-555-2671", false},
+		{"14155552671", false},
+		{"+1415555267", false},
+		{"+11155552671", false},
+		{"+9991234567", false},
+		{"+4915123456789012", false},
+	}
+	for _, test := range tests {
+		if actual := PhoneE164(test.param); actual != test.expected {
+			t.Errorf("Expected PhoneE164(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestPhone(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		region   string
+		expected bool
+	}{
+		{"", "US", false},
+		{"(415) 555-2671", "US", true},
+		{"(415) 555-2671", "us", true},
+		{"(415) 555-2671", "", false},
+		{"(415) 555-2671", "XX", false},
+		{"+44 20 7946 0018", "US", true},
+		{"020 7946 0018", "GB", true},
+		{"20 7946 0018", "GB", true},
+		{"020 7946 001", "GB", true},
+		{"020 7946", "GB", false},
+		{"040 7946 0018", "GB", false},
+		{"020 7946 0018 ext 5", "GB", false},
+		{"++44 20 7946 0018", "GB", false},
+		{"0151 23456789", "DE", true},
+		{"06 1 234 5678", "HU", true},
+		{"8 (495) 123-45-67", "RU", true},
+	}
+	for _, test := range tests {
+		if actual := Phone(test.param, test.region); actual != test.expected {
+			t.Errorf("Expected Phone(%q, %q) to be %v, got %v", test.param, test.region, test.expected, actual)
+		}
+	}
+}
+
+func TestParsePhone(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		region   string
+		expected string
+		number   PhoneNumber
+	}{
+		{"+1 415 555 2671", "", "+14155552671", PhoneNumber{"1", "US", "4155552671", PhoneFixedLineOrMobile}},
+		{"1-415-555-2671", "US", "+14155552671", PhoneNumber{"1", "US", "4155552671", PhoneFixedLineOrMobile}},
+		{"604.555.1234", "US", "+16045551234", PhoneNumber{"1", "CA", "6045551234", PhoneFixedLineOrMobile}},
+		{"+44 20 7946 0018", "", "+442079460018", PhoneNumber{"44", "GB", "2079460018", PhoneFixedLine}},
+		{"07700 900123", "GB", "+447700900123", PhoneNumber{"44", "GB", "7700900123", PhoneMobile}},
+		{"0800 123 4567", "GB", "+448001234567", PhoneNumber{"44", "GB", "8001234567", PhoneUnknown}},
+		{"030 123456", "DE", "+4930123456", PhoneNumber{"49", "DE", "30123456", PhoneFixedLine}},
+		{"0151 23456789", "DE", "+4915123456789", PhoneNumber{"49", "DE", "15123456789", PhoneMobile}},
+		{"06 12 34 56 78", "FR", "+33612345678", PhoneNumber{"33", "FR", "612345678", PhoneMobile}},
+		{"+39 06 1234 5678", "", "+390612345678", PhoneNumber{"39", "IT", "0612345678", PhoneFixedLine}},
+		{"312 345 6789", "IT", "+393123456789", PhoneNumber{"39", "IT", "3123456789", PhoneMobile}},
+		{"8 (495) 123-45-67", "RU", "+74951234567", PhoneNumber{"7", "RU", "4951234567", PhoneFixedLine}},
+		{"+7 701 123 4567", "", "+77011234567", PhoneNumber{"7", "KZ", "7011234567", PhoneMobile}},
+		{"(11) 3123-4567", "BR", "+551131234567", PhoneNumber{"55", "BR", "1131234567", PhoneFixedLine}},
+		{"+55 11 91234 5678", "", "+5511912345678", PhoneNumber{"55", "BR", "11912345678", PhoneMobile}},
+		{"0532 123 45 67", "TR", "+905321234567", PhoneNumber{"90", "TR", "5321234567", PhoneMobile}},
+		{"06 1 234 5678", "HU", "+3612345678", PhoneNumber{"36", "HU", "12345678", PhoneFixedLine}},
+		{"+86 138 0013 8000", "", "+8613800138000", PhoneNumber{"86", "CN", "13800138000", PhoneMobile}},
+		{"090-1234-5678", "JP", "+819012345678", PhoneNumber{"81", "JP", "9012345678", PhoneMobile}},
+		{"+358 50 1234567", "", "+358501234567", PhoneNumber{"358", "FI", "501234567", PhoneMobile}},
+	}
+	for _, test := range tests {
+		actual, err := ParsePhone(test.param, test.region)
+		if err != nil || actual != test.number || actual.String() != test.expected {
+			t.Errorf("Expected ParsePhone(%q, %q) to be %s %+v, got %s %+v, %v", test.param, test.region, test.expected, test.number, actual, actual, err)
+		}
+	}
+}
+
+func TestParsePhoneErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		region string
+		code   string
+		offset int
+	}{
+		{"", "GB", CodeEmpty, -1},
+		{"+", "", CodeTooShort, -1},
+		{"+999 1234567", "", CodeUnknownCountry, 1},
+		{"020 7946 0018", "", CodeUnknownCountry, -1},
+		{"020 7946 0018", "XX", CodeUnknownCountry, -1},
+		{"020 7946", "GB", CodeTooShort, -1},
+		{"020 7946 00181", "GB", CodeTooLong, 13},
+		{"040 7946 0018", "GB", CodeBadFormat, 1},
+		{"+44 (0) 20 7946 0018", "", CodeTooLong, 19},
+		{"+44 20 7946 0018x", "", CodeInvalidChar, 16},
+		{"+1 234 567 8901 2345 6789", "", CodeTooLong, 23},
+	}
+	for _, test := range tests {
+		_, err := ParsePhone(test.param, test.region)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "Phone" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParsePhone(%q, %q) to fail with %s at %d, got %v", test.param, test.region, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestPhoneTypeString(t *testing.T) {
+	t.Parallel()
+
+	if s := PhoneMobile.String(); s != "mobile" {
+		t.Errorf("Expected PhoneMobile.String() to be mobile, got %q", s)
+	}
+	if s := PhoneType(42).String(); s != "unknown" {
+		t.Errorf("Expected PhoneType(42).String() to be unknown, got %q", s)
+	}
+}
@@ -0,0 +1,272 @@
+package is
+
+import "strings"
+
+// PostalCodeEntry stores the postal code formats of a country
+type PostalCodeEntry struct {
+	// Country is the Alpha2Code of the country in ISO3166List.
+	Country string
+	// Format lists the accepted formats separated by "|", or is empty if the
+	// country has no postal codes. In a format '#' is a digit, '@' a letter
+	// and '*' a letter or digit; spaces and hyphens are the canonical
+	// separators and any other character stands for itself. A format that
+	// starts with the country code, as LT-#####, also matches codes written
+	// without it.
+	Format string
+}
+
+// PostalCodeList based on the postal addressing systems published by the
+// Universal Postal Union
+var PostalCodeList = []PostalCodeEntry{
+	{"AD", "AD###"},
+	{"AE", ""},
+	{"AG", ""},
+	{"AO", ""},
+	{"AR", "####|@####@@@"},
+	{"AT", "####"},
+	{"AU", "####"},
+	{"AW", ""},
+	{"AZ", "AZ ####"},
+	{"BA", "#####"},
+	{"BD", "####"},
+	{"BE", "####"},
+	{"BF", ""},
+	{"BG", "####"},
+	{"BI", ""},
+	{"BJ", ""},
+	{"BR", "#####-###"},
+	{"BS", ""},
+	{"BW", ""},
+	{"BY", "######"},
+	{"BZ", ""},
+	{"CA", "@#@ #@#"},
+	{"CD", ""},
+	{"CF", ""},
+	{"CG", ""},
+	{"CH", "####"},
+	{"CI", ""},
+	{"CK", ""},
+	{"CL", "#######"},
+	{"CM", ""},
+	{"CN", "######"},
+	{"CO", "######"},
+	{"CR", "#####"},
+	{"CY", "####"},
+	{"CZ", "### ##"},
+	{"DE", "#####"},
+	{"DJ", ""},
+	{"DK", "####"},
+	{"DM", ""},
+	{"DZ", "#####"},
+	{"EE", "#####"},
+	{"EG", "#####"},
+	{"ER", ""},
+	{"ES", "#####"},
+	{"FI", "#####"},
+	{"FJ", ""},
+	{"FR", "#####"},
+	{"GA", ""},
+	// the outward code is followed by the inward code, e.g. SW1A 1AA
+	{"GB", "@# #@@|@## #@@|@@# #@@|@@## #@@|@#@ #@@|@@#@ #@@|GIR 0AA"},
+	{"GD", ""},
+	{"GM", ""},
+	{"GQ", ""},
+	{"GR", "### ##"},
+	{"GY", ""},
+	{"HK", ""},
+	{"HR", "#####"},
+	{"HU", "####"},
+	{"ID", "#####"},
+	// Eircode: a routing key and a unique identifier, e.g. D02 X285
+	{"IE", "@## ****|D6W ****"},
+	{"IL", "#######"},
+	{"IN", "######"},
+	{"IS", "###"},
+	{"IT", "#####"},
+	{"JP", "###-####"},
+	{"KI", ""},
+	{"KM", ""},
+	{"KN", ""},
+	{"KP", ""},
+	{"KR", "#####"},
+	{"KZ", "######"},
+	{"LI", "####"},
+	{"LT", "LT-#####"},
+	{"LU", "####"},
+	{"LV", "LV-####"},
+	{"MA", "#####"},
+	{"MC", "980##"},
+	{"ML", ""},
+	{"MO", ""},
+	{"MR", ""},
+	{"MW", ""},
+	{"MX", "#####"},
+	{"MY", "#####"},
+	{"NL", "#### @@"},
+	{"NO", "####"},
+	{"NR", ""},
+	{"NU", ""},
+	{"NZ", "####"},
+	{"PH", "####"},
+	{"PK", "#####"},
+	{"PL", "##-###"},
+	{"PT", "####-###"},
+	{"QA", ""},
+	{"RO", "######"},
+	{"RS", "#####"},
+	{"RU", "######"},
+	{"RW", ""},
+	{"SA", "#####|#####-####"},
+	{"SB", ""},
+	{"SC", ""},
+	{"SE", "### ##"},
+	{"SG", "######"},
+	{"SI", "####"},
+	{"SK", "### ##"},
+	{"SL", ""},
+	{"SR", ""},
+	{"ST", ""},
+	{"SY", ""},
+	{"TD", ""},
+	{"TG", ""},
+	{"TH", "#####"},
+	{"TK", ""},
+	{"TL", ""},
+	{"TO", ""},
+	{"TR", "#####"},
+	{"TV", ""},
+	{"TW", "###|###-##|###-###"},
+	{"UA", "#####"},
+	{"UG", ""},
+	{"US", "#####|#####-####"},
+	{"VN", "######"},
+	{"VU", ""},
+	{"YE", ""},
+	{"ZA", "####"},
+	{"ZW", ""},
+}
+
+// postalCodeMaxLength bounds the length of a postal code without separators.
+const postalCodeMaxLength = 16
+
+// PostalCode check if the string is a postal code of the country, given as an
+// Alpha2Code of PostalCodeList. Letter case and the placement of spaces and
+// hyphens are not checked; use NormalizePostalCode to get the canonical form.
+// It returns false for countries without postal codes and for countries
+// missing from PostalCodeList, see HasPostalCode.
+func PostalCode(code, country string) bool {
+	_, err := NormalizePostalCode(code, country)
+	return err == nil
+}
+
+// HasPostalCode check if the country, given as an Alpha2Code, uses postal
+// codes. Known is false if the country is missing from PostalCodeList, in
+// which case has is false too.
+func HasPostalCode(country string) (has, known bool) {
+	e := postalCodeEntry(country)
+	if e == nil {
+		return false, false
+	}
+	return e.Format != "", true
+}
+
+// NormalizePostalCode validates a postal code like PostalCode and returns it
+// in the canonical case and spacing of the country, e.g. "sw1a1aa" becomes
+// "SW1A 1AA" in GB, "12345 6789" becomes "12345-6789" in US and "01100"
+// becomes "LT-01100" in LT. On failure the error is a *ValidationError.
+func NormalizePostalCode(code, country string) (string, error) {
+	e := postalCodeEntry(country)
+	if e == nil {
+		return "", invalid("PostalCode", CodeUnknownCountry, -1)
+	}
+	if code == "" {
+		return "", invalid("PostalCode", CodeEmpty, -1)
+	}
+
+	// upper case copy of code without separators
+	var buf [postalCodeMaxLength]byte
+	n := 0
+	for i := 0; i < len(code); i++ {
+		c := code[i]
+		if c == ' ' || c == '-' {
+			continue
+		}
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if ('Z' < c || c < 'A') && ('9' < c || c < '0') {
+			return "", invalid("PostalCode", CodeInvalidChar, i)
+		}
+		if n == len(buf) {
+			return "", invalid("PostalCode", CodeTooLong, i)
+		}
+		buf[n] = c
+		n++
+	}
+
+	if e.Format != "" {
+		for _, format := range strings.Split(e.Format, "|") {
+			if s, ok := applyFormat(format, buf[:n]); ok {
+				return s, nil
+			}
+			if strings.HasPrefix(format, e.Country) {
+				// the country prefix is often left out
+				rest := strings.TrimLeft(format[len(e.Country):], " -")
+				if s, ok := applyFormat(rest, buf[:n]); ok {
+					return format[:len(format)-len(rest)] + s, nil
+				}
+			}
+		}
+	}
+	return "", invalid("PostalCode", CodeBadFormat, -1)
+}
+
+// postalCodeEntry returns the PostalCodeList entry of the country, or nil.
+func postalCodeEntry(country string) *PostalCodeEntry {
+	for i := range PostalCodeList {
+		if strings.EqualFold(PostalCodeList[i].Country, country) {
+			return &PostalCodeList[i]
+		}
+	}
+	return nil
+}
+
+// applyFormat matches the compact upper case code against a format in the
+// syntax of PostalCodeEntry and returns the code with the format's separators.
+func applyFormat(format string, code []byte) (string, bool) {
+	out := make([]byte, 0, len(format))
+	i := 0
+	for j := 0; j < len(format); j++ {
+		f := format[j]
+		if f == ' ' || f == '-' {
+			out = append(out, f)
+			continue
+		}
+		if i == len(code) {
+			return "", false
+		}
+		c := code[i]
+		isDigit := '0' <= c && c <= '9'
+		switch f {
+		case '#':
+			if !isDigit {
+				return "", false
+			}
+		case '@':
+			if isDigit {
+				return "", false
+			}
+		case '*':
+		default:
+			if c != f {
+				return "", false
+			}
+		}
+		out = append(out, c)
+		i++
+	}
+	if i != len(code) {
+		return "", false
+	}
+	return string(out), true
+}
@@ -0,0 +1,152 @@
+package is
+
+import "testing"
+
+func TestPostalCodeList(t *testing.T) {
+	t.Parallel()
+
+	for _, e := range PostalCodeList {
+		if !ISO3166Alpha2(e.Country) {
+			t.Errorf("PostalCodeList entry %s is not in ISO3166List", e.Country)
+		}
+	}
+}
+
+func TestPostalCode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		country  string
+		expected bool
+	}{
+		{"", "US", false},
+		{"94103", "US", true},
+		{"94103-1234", "US", true},
+		{"941031234", "US", true},
+		{"9410", "US", false},
+		{"94103-123", "US", false},
+		{"SW1A 1AA", "GB", true},
+		{"sw1a1aa", "GB", true},
+		{"M1 1AE", "GB", true},
+		{"B33 8TH", "GB", true},
+		{"CR2 6XH", "GB", true},
+		{"DN55 1PT", "GB", true},
+		{"GIR 0AA", "GB", true},
+		{"SW1A 1A", "GB", false},
+		{"1W1A 1AA", "GB", false},
+		{"K1A 0B1", "CA", true},
+		{"K1A0B1", "CA", true},
+		{"K1A 0BB", "CA", false},
+		{"1012 AB", "NL", true},
+		{"1012AB", "NL", true},
+		{"1012 A1", "NL", false},
+		{"01310-200", "BR", true},
+		{"01310200", "BR", true},
+		{"34000", "TR", true},
+		{"340000", "TR", false},
+		{"D02 X285", "IE", true},
+		{"D6W XY12", "IE", true},
+		{"LT-01100", "LT", true},
+		{"01100", "LT", true},
+		{"0110", "LT", false},
+		{"LV-1050", "LV", true},
+		{"1050", "LV", true},
+		{"LT-1050", "LV", false},
+		{"100-0001", "JP", true},
+		{"00-950", "PL", true},
+		{"98000", "MC", true},
+		{"75001", "MC", false},
+		{"C1425DKF", "AR", true},
+		{"94103", "us", true},
+		{"94103", "XX", false},
+		{"94103", "", false},
+		{"00000", "HK", false},
+		{"941.03", "US", false},
+	}
+	for _, test := range tests {
+		if actual := PostalCode(test.param, test.country); actual != test.expected {
+			t.Errorf("Expected PostalCode(%q, %q) to be %v, got %v", test.param, test.country, test.expected, actual)
+		}
+	}
+}
+
+func TestHasPostalCode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param string
+		has   bool
+		known bool
+	}{
+		{"US", true, true},
+		{"gb", true, true},
+		{"HK", false, true},
+		{"AE", false, true},
+		{"XX", false, false},
+		{"", false, false},
+	}
+	for _, test := range tests {
+		if has, known := HasPostalCode(test.param); has != test.has || known != test.known {
+			t.Errorf("Expected HasPostalCode(%q) to be %v, %v, got %v, %v", test.param, test.has, test.known, has, known)
+		}
+	}
+}
+
+func TestNormalizePostalCode(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		country  string
+		expected string
+	}{
+		{"sw1a1aa", "GB", "SW1A 1AA"},
+		{" SW1A  1AA ", "GB", "SW1A 1AA"},
+		{"k1a0b1", "CA", "K1A 0B1"},
+		{"1012ab", "NL", "1012 AB"},
+		{"12345 6789", "US", "12345-6789"},
+		{"123456789", "US", "12345-6789"},
+		{"01310200", "BR", "01310-200"},
+		{"11455", "SE", "114 55"},
+		{"lt01100", "LT", "LT-01100"},
+		{"01100", "LT", "LT-01100"},
+		{"1050", "LV", "LV-1050"},
+		{"1000", "AZ", "AZ 1000"},
+		{"az1000", "AZ", "AZ 1000"},
+		{"500", "AD", "AD500"},
+		{"d02x285", "IE", "D02 X285"},
+		{"1000", "BE", "1000"},
+	}
+	for _, test := range tests {
+		actual, err := NormalizePostalCode(test.param, test.country)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected NormalizePostalCode(%q, %q) to be %q, got %q, %v", test.param, test.country, test.expected, actual, err)
+		}
+	}
+}
+
+func TestNormalizePostalCodeErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param   string
+		country string
+		code    string
+		offset  int
+	}{
+		{"", "US", CodeEmpty, -1},
+		{"94103", "XX", CodeUnknownCountry, -1},
+		{"941.03", "US", CodeInvalidChar, 3},
+		{"12345678901234567", "US", CodeTooLong, 16},
+		{"9410", "US", CodeBadFormat, -1},
+		{"00000", "HK", CodeBadFormat, -1},
+	}
+	for _, test := range tests {
+		_, err := NormalizePostalCode(test.param, test.country)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "PostalCode" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected NormalizePostalCode(%q, %q) to fail with %s at %d, got %v", test.param, test.country, test.code, test.offset, err)
+		}
+	}
+}
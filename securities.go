@@ -0,0 +1,257 @@
+package is
+
+import (
+	"strings"
+
+	"github.com/alioygur/is/checkdigit"
+)
+
+// ISIN check if the string is an International Securities Identification
+// Number (ISO 6166) such as US0378331005: the ISO 3166 alpha-2 code of the
+// issuing country, or XS, EU or XA to XD for international securities, 9
+// upper case letters or digits and a check digit. The check digit passes the
+// Luhn algorithm once letters are expanded to the two digits of their values
+// 10 to 35.
+func ISIN(str string) bool {
+	code, _ := isin(str)
+	return code == ""
+}
+
+// CheckISIN is like ISIN but returns a *ValidationError explaining why str was rejected.
+func CheckISIN(str string) error {
+	if code, offset := isin(str); code != "" {
+		return invalid("ISIN", code, offset)
+	}
+	return nil
+}
+
+func isin(str string) (string, int) {
+	if code, offset := fixedLength(str, 12); code != "" {
+		return code, offset
+	}
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if alnumValue(c) < 0 || i < 2 && c <= '9' || i == 11 && c > '9' {
+			return CodeInvalidChar, i
+		}
+	}
+	switch cc := str[:2]; cc {
+	case "XS", "EU", "XA", "XB", "XC", "XD":
+	default:
+		if !ISO3166Alpha2(cc) {
+			return CodeUnknownCountry, 0
+		}
+	}
+
+	// letters are expanded to the two digits of their values
+	var digits [23]byte
+	n := 0
+	for i := 0; i < len(str); i++ {
+		if v := alnumValue(str[i]); v >= 10 {
+			digits[n], digits[n+1] = byte('0'+v/10), byte('0'+v%10)
+			n += 2
+		} else {
+			digits[n] = str[i]
+			n++
+		}
+	}
+	if !(checkdigit.Luhn{}).Validate(string(digits[:n])) {
+		return CodeBadChecksum, 11
+	}
+	return "", 0
+}
+
+// CUSIP check if the string is a CUSIP number of North American securities
+// such as 037833100: 8 upper case letters, digits or the characters *, @
+// and #, and a check digit over their values doubled at every other position.
+func CUSIP(str string) bool {
+	code, _ := cusip(str)
+	return code == ""
+}
+
+// CheckCUSIP is like CUSIP but returns a *ValidationError explaining why str was rejected.
+func CheckCUSIP(str string) error {
+	if code, offset := cusip(str); code != "" {
+		return invalid("CUSIP", code, offset)
+	}
+	return nil
+}
+
+func cusip(str string) (string, int) {
+	if code, offset := fixedLength(str, 9); code != "" {
+		return code, offset
+	}
+	sum := 0
+	for i := 0; i < 8; i++ {
+		v := alnumValue(str[i])
+		if v < 0 {
+			// the special characters follow the letters
+			v = strings.IndexByte("*@#", str[i])
+			if v < 0 {
+				return CodeInvalidChar, i
+			}
+			v += 36
+		}
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	if str[8] < '0' || str[8] > '9' {
+		return CodeInvalidChar, 8
+	}
+	if int(str[8]-'0') != (10-sum%10)%10 {
+		return CodeBadChecksum, 8
+	}
+	return "", 0
+}
+
+// SEDOL check if the string is a Stock Exchange Daily Official List number
+// such as 0263494 or B0YBKJ7: 6 digits or upper case consonants and a check
+// digit over their values weighted 1, 3, 1, 7, 3 and 9.
+func SEDOL(str string) bool {
+	code, _ := sedol(str)
+	return code == ""
+}
+
+// CheckSEDOL is like SEDOL but returns a *ValidationError explaining why str was rejected.
+func CheckSEDOL(str string) error {
+	if code, offset := sedol(str); code != "" {
+		return invalid("SEDOL", code, offset)
+	}
+	return nil
+}
+
+func sedol(str string) (string, int) {
+	if code, offset := fixedLength(str, 7); code != "" {
+		return code, offset
+	}
+	weights := [6]int{1, 3, 1, 7, 3, 9}
+	sum := 0
+	for i, w := range weights {
+		v := alnumValue(str[i])
+		if v < 0 || isVowel(str[i]) {
+			return CodeInvalidChar, i
+		}
+		sum += v * w
+	}
+	if str[6] < '0' || str[6] > '9' {
+		return CodeInvalidChar, 6
+	}
+	if int(str[6]-'0') != (10-sum%10)%10 {
+		return CodeBadChecksum, 6
+	}
+	return "", 0
+}
+
+// LEI check if the string is a Legal Entity Identifier (ISO 17442) such as
+// 5493001KJTIIGC8Y1R12: 18 upper case letters or digits and 2 check digits,
+// the whole passing ISO 7064 MOD 97-10 with letters valued 10 to 35.
+func LEI(str string) bool {
+	code, _ := lei(str)
+	return code == ""
+}
+
+// CheckLEI is like LEI but returns a *ValidationError explaining why str was rejected.
+func CheckLEI(str string) error {
+	if code, offset := lei(str); code != "" {
+		return invalid("LEI", code, offset)
+	}
+	return nil
+}
+
+func lei(str string) (string, int) {
+	if code, offset := fixedLength(str, 20); code != "" {
+		return code, offset
+	}
+	for i := 0; i < len(str); i++ {
+		if c := str[i]; alnumValue(c) < 0 || i >= 18 && c > '9' {
+			return CodeInvalidChar, i
+		}
+	}
+	if !(checkdigit.Mod97Radix10{}).Validate(str) {
+		return CodeBadChecksum, 18
+	}
+	return "", 0
+}
+
+// FIGI check if the string is a Financial Instrument Global Identifier such
+// as BBG000BLNNH6: 2 upper case consonants, G, 8 digits or upper case
+// consonants and a check digit computed like the Luhn algorithm over the
+// values of the characters, letters counting 10 to 35. The prefixes BS, BM,
+// GG, GB, GH, KY and VG are not used, to avoid confusion with ISINs.
+func FIGI(str string) bool {
+	code, _ := figi(str)
+	return code == ""
+}
+
+// CheckFIGI is like FIGI but returns a *ValidationError explaining why str was rejected.
+func CheckFIGI(str string) error {
+	if code, offset := figi(str); code != "" {
+		return invalid("FIGI", code, offset)
+	}
+	return nil
+}
+
+func figi(str string) (string, int) {
+	if code, offset := fixedLength(str, 12); code != "" {
+		return code, offset
+	}
+	sum := 0
+	for i := 0; i < 11; i++ {
+		c := str[i]
+		v := alnumValue(c)
+		if v < 0 || isVowel(c) || i < 3 && c <= '9' {
+			return CodeInvalidChar, i
+		}
+		if i%2 == 1 {
+			v *= 2
+		}
+		sum += v/10 + v%10
+	}
+	if str[11] < '0' || str[11] > '9' {
+		return CodeInvalidChar, 11
+	}
+	switch str[:2] {
+	case "BS", "BM", "GG", "GB", "GH", "KY", "VG":
+		return CodeBadFormat, 0
+	}
+	if str[2] != 'G' {
+		return CodeBadFormat, 2
+	}
+	if int(str[11]-'0') != (10-sum%10)%10 {
+		return CodeBadChecksum, 11
+	}
+	return "", 0
+}
+
+// fixedLength returns the error code and offset of a string that is not of
+// length n, or "".
+func fixedLength(str string, n int) (string, int) {
+	switch {
+	case str == "":
+		return CodeEmpty, -1
+	case len(str) < n:
+		return CodeTooShort, -1
+	case len(str) > n:
+		return CodeTooLong, n
+	}
+	return "", 0
+}
+
+// alnumValue returns the value of an ASCII digit, or of an upper case letter
+// counting from 10 for A to 35 for Z, or -1.
+func alnumValue(c byte) int {
+	switch {
+	case '0' <= c && c <= '9':
+		return int(c - '0')
+	case 'A' <= c && c <= 'Z':
+		return int(c-'A') + 10
+	}
+	return -1
+}
+
+// isVowel reports whether c is an upper case vowel.
+func isVowel(c byte) bool {
+	return c == 'A' || c == 'E' || c == 'I' || c == 'O' || c == 'U'
+}
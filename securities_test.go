@@ -0,0 +1,99 @@
+package is
+
+import "testing"
+
+func TestSecurities(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) bool
+		name     string
+		param    string
+		expected bool
+	}{
+		{ISIN, "ISIN", "US0378331005", true},
+		{ISIN, "ISIN", "AU0000XVGZA3", true},
+		{ISIN, "ISIN", "GB0002634946", true},
+		{ISIN, "ISIN", "DE000BAY0017", true},
+		{ISIN, "ISIN", "XS2021832634", true},
+		{ISIN, "ISIN", "US0378331006", false},
+		{ISIN, "ISIN", "US0373831005", false},
+		{ISIN, "ISIN", "us0378331005", false},
+		{ISIN, "ISIN", "ZZ0378331005", false},
+		{ISIN, "ISIN", "US037833100", false},
+		{ISIN, "ISIN", "", false},
+		{CUSIP, "CUSIP", "037833100", true},
+		{CUSIP, "CUSIP", "594918104", true},
+		{CUSIP, "CUSIP", "38259P508", true},
+		{CUSIP, "CUSIP", "68389X105", true},
+		{CUSIP, "CUSIP", "037833101", false},
+		{CUSIP, "CUSIP", "38259p508", false},
+		{CUSIP, "CUSIP", "03783310", false},
+		{SEDOL, "SEDOL", "0263494", true},
+		{SEDOL, "SEDOL", "B0YBKJ7", true},
+		{SEDOL, "SEDOL", "B0YBLH2", true},
+		{SEDOL, "SEDOL", "2046251", true},
+		{SEDOL, "SEDOL", "0263495", false},
+		{SEDOL, "SEDOL", "A0YBKJ7", false},
+		{SEDOL, "SEDOL", "026349", false},
+		{LEI, "LEI", "5493001KJTIIGC8Y1R12", true},
+		{LEI, "LEI", "HWUPKR0MPOU8FGXBT394", true},
+		{LEI, "LEI", "529900T8BM49AURSDO55", true},
+		{LEI, "LEI", "7LTWFZYICNSX8D621K86", true},
+		{LEI, "LEI", "5493001KJTIIGC8Y1R13", false},
+		{LEI, "LEI", "5493001KJTIIGC8Y1RAB", false},
+		{LEI, "LEI", "5493001kjtiigc8y1r12", false},
+		{FIGI, "FIGI", "BBG000BLNNH6", true},
+		{FIGI, "FIGI", "BBG000B9XRY4", true},
+		{FIGI, "FIGI", "BBG000BPH459", true},
+		{FIGI, "FIGI", "BBG000BLNNH7", false},
+		{FIGI, "FIGI", "BBG000BLANH6", false},
+		{FIGI, "FIGI", "BBX000BLNNH6", false},
+		{FIGI, "FIGI", "GBG000BLNNH6", false},
+	}
+	for _, test := range tests {
+		if actual := test.function(test.param); actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestCheckSecurities(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) error
+		name     string
+		param    string
+		code     string
+		offset   int
+	}{
+		{CheckISIN, "ISIN", "", CodeEmpty, -1},
+		{CheckISIN, "ISIN", "US037833100", CodeTooShort, -1},
+		{CheckISIN, "ISIN", "US03783310051", CodeTooLong, 12},
+		{CheckISIN, "ISIN", "U10378331005", CodeInvalidChar, 1},
+		{CheckISIN, "ISIN", "US037833100X", CodeInvalidChar, 11},
+		{CheckISIN, "ISIN", "ZZ0378331005", CodeUnknownCountry, 0},
+		{CheckISIN, "ISIN", "US0378331006", CodeBadChecksum, 11},
+		{CheckCUSIP, "CUSIP", "0378-3100", CodeInvalidChar, 4},
+		{CheckCUSIP, "CUSIP", "037833101", CodeBadChecksum, 8},
+		{CheckSEDOL, "SEDOL", "A0YBKJ7", CodeInvalidChar, 0},
+		{CheckSEDOL, "SEDOL", "0263495", CodeBadChecksum, 6},
+		{CheckLEI, "LEI", "5493001KJTIIGC8Y1RAB", CodeInvalidChar, 18},
+		{CheckLEI, "LEI", "5493001KJTIIGC8Y1R13", CodeBadChecksum, 18},
+		{CheckFIGI, "FIGI", "BBG000BLANH6", CodeInvalidChar, 8},
+		{CheckFIGI, "FIGI", "GBG000BLNNH6", CodeBadFormat, 0},
+		{CheckFIGI, "FIGI", "BBX000BLNNH6", CodeBadFormat, 2},
+		{CheckFIGI, "FIGI", "BBG000BLNNH7", CodeBadChecksum, 11},
+	}
+	for _, test := range tests {
+		err := test.function(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != test.name || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected Check%s(%q) to fail with %s at %d, got %v", test.name, test.param, test.code, test.offset, err)
+		}
+	}
+	if err := CheckISIN("US0378331005"); err != nil {
+		t.Errorf("Expected CheckISIN(%q) to succeed, got %v", "US0378331005", err)
+	}
+}
@@ -0,0 +1,534 @@
+package is
+
+import (
+	"math"
+	"strconv"
+	"strings"
+)
+
+// Version is a semantic version as defined by SemVer 2.0.0.
+type Version struct {
+	// Major, Minor and Patch are limited to the range of uint64; ParseVersion
+	// reports longer numbers with CodeTooLong.
+	Major, Minor, Patch uint64
+	// Prerelease holds the dot separated identifiers after the hyphen, e.g.
+	// alpha and 1 for 1.0.0-alpha.1.
+	Prerelease []string
+	// Build holds the dot separated build metadata identifiers after the
+	// plus sign. They are ignored by Compare.
+	Build []string
+}
+
+// ParseVersion parses a semantic version accepted by Semver, such as
+// 1.0.0-beta+exp.sha.5114f85 or v1.2.3. On failure the error is a
+// *ValidationError.
+func ParseVersion(str string) (Version, error) {
+	v, _, code, offset := parseVersion(str, false)
+	if code != "" {
+		return Version{}, invalid("Semver", code, offset)
+	}
+	return v, nil
+}
+
+// String returns the version in the SemVer form, without a leading v.
+func (v Version) String() string {
+	s := strconv.FormatUint(v.Major, 10) + "." + strconv.FormatUint(v.Minor, 10) + "." + strconv.FormatUint(v.Patch, 10)
+	if len(v.Prerelease) > 0 {
+		s += "-" + strings.Join(v.Prerelease, ".")
+	}
+	if len(v.Build) > 0 {
+		s += "+" + strings.Join(v.Build, ".")
+	}
+	return s
+}
+
+// Compare returns -1, 0 or +1 as v has a lower, the same or a higher
+// precedence than w. Major, minor and patch compare numerically; a
+// prerelease is lower than its normal version, and prereleases compare
+// identifier by identifier, numeric ones numerically and lower than
+// alphanumeric ones, which compare in ASCII order, a shorter list being lower
+// when all its identifiers are equal. Build metadata is ignored.
+func (v Version) Compare(w Version) int {
+	if c := compareUint(v.Major, w.Major); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Minor, w.Minor); c != 0 {
+		return c
+	}
+	if c := compareUint(v.Patch, w.Patch); c != 0 {
+		return c
+	}
+	switch {
+	case len(v.Prerelease) == 0 && len(w.Prerelease) == 0:
+		return 0
+	case len(v.Prerelease) == 0:
+		return 1
+	case len(w.Prerelease) == 0:
+		return -1
+	}
+	for i := 0; i < len(v.Prerelease) && i < len(w.Prerelease); i++ {
+		if c := compareIdentifier(v.Prerelease[i], w.Prerelease[i]); c != 0 {
+			return c
+		}
+	}
+	return compareUint(uint64(len(v.Prerelease)), uint64(len(w.Prerelease)))
+}
+
+func compareUint(a, b uint64) int {
+	switch {
+	case a < b:
+		return -1
+	case a > b:
+		return 1
+	}
+	return 0
+}
+
+// compareIdentifier compares two prerelease identifiers. Numeric ones have
+// no leading zeros, so the longer is the larger.
+func compareIdentifier(a, b string) int {
+	an, bn := Numeric(a), Numeric(b)
+	switch {
+	case an && !bn:
+		return -1
+	case !an && bn:
+		return 1
+	case an && len(a) != len(b):
+		return compareUint(uint64(len(a)), uint64(len(b)))
+	}
+	return strings.Compare(a, b)
+}
+
+// parseVersion parses a version, or with partial a version in a
+// constraint, where trailing components may be missing or a wildcard x, X or
+// *. It returns the number of components given as numbers, the error code
+// and offset, or "".
+func parseVersion(str string, partial bool) (Version, int, string, int) {
+	var v Version
+	if str == "" {
+		return v, 0, CodeEmpty, -1
+	}
+	i, n := 0, 0
+	if str[0] == 'v' {
+		i = 1
+	}
+	components := [3]*uint64{&v.Major, &v.Minor, &v.Patch}
+	wildcard := false
+	for k := 0; k < 3; k++ {
+		if i == len(str) {
+			return v, n, CodeBadFormat, -1
+		}
+		if c := str[i]; partial && (c == 'x' || c == 'X' || c == '*') {
+			wildcard = true
+			i++
+		} else {
+			j := i
+			for j < len(str) && '0' <= str[j] && str[j] <= '9' {
+				j++
+			}
+			switch {
+			case j == i || j-i > 1 && str[i] == '0':
+				return v, n, CodeInvalidChar, i
+			case wildcard:
+				return v, n, CodeBadFormat, i
+			}
+			x, err := strconv.ParseUint(str[i:j], 10, 64)
+			if err != nil {
+				return v, n, CodeTooLong, i
+			}
+			*components[k] = x
+			n++
+			i = j
+		}
+		if k == 2 || i == len(str) || str[i] != '.' {
+			break
+		}
+		i++
+	}
+	if n < 3 && !partial {
+		if i == len(str) {
+			return v, n, CodeBadFormat, -1
+		}
+		return v, n, CodeInvalidChar, i
+	}
+
+	var code string
+	if i < len(str) && str[i] == '-' {
+		if n < 3 {
+			return v, n, CodeBadFormat, i
+		}
+		if v.Prerelease, i, code = versionIdentifiers(str, i+1, true); code != "" {
+			return v, n, code, i
+		}
+	}
+	if i < len(str) && str[i] == '+' {
+		if v.Build, i, code = versionIdentifiers(str, i+1, false); code != "" {
+			return v, n, code, i
+		}
+	}
+	if i < len(str) {
+		return v, n, CodeInvalidChar, i
+	}
+	return v, n, "", 0
+}
+
+// versionIdentifiers reads the dot separated identifiers of a prerelease or
+// of build metadata from str[i:]. Numeric prerelease identifiers must not
+// have leading zeros. It returns the offset after them, or of the error.
+func versionIdentifiers(str string, i int, prerelease bool) ([]string, int, string) {
+	var ids []string
+	for {
+		start := i
+		for i < len(str) && (alnumValue(str[i]) >= 0 || 'a' <= str[i] && str[i] <= 'z' || str[i] == '-') {
+			i++
+		}
+		id := str[start:i]
+		switch {
+		case id == "":
+			return nil, i, CodeInvalidChar
+		case prerelease && len(id) > 1 && id[0] == '0' && Numeric(id):
+			return nil, start, CodeInvalidChar
+		}
+		ids = append(ids, id)
+		if i == len(str) || str[i] != '.' {
+			return ids, i, ""
+		}
+		i++
+	}
+}
+
+// SemverConstraint is a set of versions, parsed by ParseSemverConstraint.
+type SemverConstraint struct {
+	ranges []semverRange
+}
+
+// semverRange is an intersection of comparators. A prerelease version is
+// only in the range if a version of the constraint with the same major,
+// minor and patch has a prerelease too.
+type semverRange struct {
+	comparators []semverComparator
+	prereleases [][3]uint64
+}
+
+type semverComparator struct {
+	op string
+	v  Version
+}
+
+// SemverConstraintOptions selects the syntax of ParseSemverConstraintWith.
+// The zero value is the syntax of npm.
+type SemverConstraintOptions struct {
+	// Composer reads constraints as Composer does: a single | separates
+	// ranges as well as ||, and ~ lets the last given component increase, so
+	// ~1.2 is >=1.2.0 <2.0.0 and ~1.2.3 is >=1.2.3 <1.3.0. Versions keep the
+	// SemVer grammar; Composer's stability flags such as @dev are not
+	// supported.
+	Composer bool
+}
+
+// ParseSemverConstraint parses a constraint on semantic versions in the
+// syntax of npm, such as ^1.4.0, ~2.1 or
+// >=1.2.3 <2.0.0 || 3.x. A constraint is a union of ranges separated by ||;
+// a range is an intersection of comparators separated by spaces or commas,
+// or a hyphen range such as 1.2 - 2.3.4. Comparators are made of one of the
+// operators =, !=, <, <=, > and >=, or none for =, and of a version whose
+// trailing components may be missing or replaced by x, X or *:
+//
+//	1.2.x, 1.2     >=1.2.0 <1.3.0
+//	~1.2.3         >=1.2.3 <1.3.0
+//	~1             >=1.0.0 <2.0.0
+//	^1.2.3         >=1.2.3 <2.0.0
+//	^0.2.3         >=0.2.3 <0.3.0
+//	^0.0.3         >=0.0.3 <0.0.4
+//	1.2 - 2.3      >=1.2.0 <2.4.0
+//	*              any version
+//
+// As in npm, a prerelease version only satisfies a range if one of its
+// comparators has a prerelease of the same major, minor and patch version,
+// so ^1.4.0 does not include 1.5.0-beta. On failure the error is a
+// *ValidationError.
+func ParseSemverConstraint(str string) (SemverConstraint, error) {
+	return ParseSemverConstraintWith(str, SemverConstraintOptions{})
+}
+
+// ParseSemverConstraintWith is like ParseSemverConstraint in the syntax
+// selected by opts.
+func ParseSemverConstraintWith(str string, opts SemverConstraintOptions) (SemverConstraint, error) {
+	var c SemverConstraint
+	if strings.TrimSpace(str) == "" {
+		return c, invalid("SemverConstraint", CodeEmpty, -1)
+	}
+	for start := 0; ; {
+		end, sep := strings.Index(str[start:], "||"), 2
+		if i := strings.IndexByte(str[start:], '|'); opts.Composer && i >= 0 && i != end {
+			end, sep = i, 1
+		}
+		if end < 0 {
+			end = len(str)
+		} else {
+			end += start
+		}
+		r, code, offset := parseSemverRange(str[start:end], start, opts.Composer)
+		if code != "" {
+			return SemverConstraint{}, invalid("SemverConstraint", code, offset)
+		}
+		c.ranges = append(c.ranges, r)
+		if end == len(str) {
+			return c, nil
+		}
+		start = end + sep
+	}
+}
+
+// Allows reports whether v satisfies the constraint.
+func (c SemverConstraint) Allows(v Version) bool {
+	for _, r := range c.ranges {
+		if r.allows(v) {
+			return true
+		}
+	}
+	return false
+}
+
+func (r semverRange) allows(v Version) bool {
+	for _, c := range r.comparators {
+		cmp := v.Compare(c.v)
+		var ok bool
+		switch c.op {
+		case "=":
+			ok = cmp == 0
+		case "!=":
+			ok = cmp != 0
+		case "<":
+			ok = cmp < 0
+		case "<=":
+			ok = cmp <= 0
+		case ">":
+			ok = cmp > 0
+		case ">=":
+			ok = cmp >= 0
+		}
+		if !ok {
+			return false
+		}
+	}
+	if len(v.Prerelease) == 0 {
+		return true
+	}
+	for _, p := range r.prereleases {
+		if p == [3]uint64{v.Major, v.Minor, v.Patch} {
+			return true
+		}
+	}
+	return false
+}
+
+// SemverSatisfies check if the string is a semantic version accepted by
+// Semver that satisfies the constraint, in the syntax of
+// ParseSemverConstraint. It is false if the constraint is invalid.
+func SemverSatisfies(version, constraint string) bool {
+	return SemverSatisfiesWith(version, constraint, SemverConstraintOptions{})
+}
+
+// SemverSatisfiesWith is like SemverSatisfies for a constraint in the syntax
+// selected by opts.
+func SemverSatisfiesWith(version, constraint string, opts SemverConstraintOptions) bool {
+	v, err := ParseVersion(version)
+	if err != nil {
+		return false
+	}
+	c, err := ParseSemverConstraintWith(constraint, opts)
+	return err == nil && c.Allows(v)
+}
+
+// semverOperators are the comparison operators of constraints, longest first.
+var semverOperators = []string{">=", "<=", "!=", ">", "<", "=", "^", "~"}
+
+// parseSemverRange parses a range of a constraint that starts at offset base,
+// in the syntax of Composer if composer is set.
+func parseSemverRange(str string, base int, composer bool) (semverRange, string, int) {
+	var r semverRange
+	type token struct {
+		s      string
+		offset int
+	}
+	var tokens []token
+	for i := 0; i < len(str); {
+		if str[i] == ' ' || str[i] == '\t' || str[i] == ',' {
+			i++
+			continue
+		}
+		j := i
+		for j < len(str) && str[j] != ' ' && str[j] != '\t' && str[j] != ',' {
+			j++
+		}
+		tokens = append(tokens, token{str[i:j], base + i})
+		i = j
+	}
+	if len(tokens) == 0 {
+		return r, CodeBadFormat, base
+	}
+
+	for k := 0; k < len(tokens); k++ {
+		tok := tokens[k]
+		if k+2 < len(tokens) && tokens[k+1].s == "-" {
+			// hyphen range
+			last := tokens[k+2]
+			lo, loN, code, offset := parseVersion(tok.s, true)
+			if code != "" {
+				return r, code, tokenOffset(tok.offset, offset)
+			}
+			hi, hiN, code, offset := parseVersion(last.s, true)
+			if code != "" {
+				return r, code, tokenOffset(last.offset, offset)
+			}
+			if loN > 0 {
+				r.add(">=", lo, loN)
+			}
+			switch {
+			case hiN == 3:
+				r.add("<=", hi, hiN)
+			case hiN > 0:
+				r.addBelow(hi, hiN)
+			}
+			k += 2
+			continue
+		}
+
+		op := ""
+		for _, o := range semverOperators {
+			if strings.HasPrefix(tok.s, o) {
+				op = o
+				break
+			}
+		}
+		s, offset := tok.s[len(op):], tok.offset+len(op)
+		if s == "" && op != "" && k+1 < len(tokens) {
+			// an operator separated from its version
+			k++
+			s, offset = tokens[k].s, tokens[k].offset
+		}
+		v, n, code, off := parseVersion(s, true)
+		if code != "" {
+			if code == CodeEmpty {
+				return r, CodeBadFormat, offset
+			}
+			return r, code, tokenOffset(offset, off)
+		}
+		if op == "!=" && n < 3 {
+			return r, CodeBadFormat, tok.offset
+		}
+		r.addComparator(op, v, n, composer)
+	}
+	return r, "", 0
+}
+
+// tokenOffset returns the offset in the constraint of an error at offset in
+// a token starting at start, or at the token if the error has no offset.
+func tokenOffset(start, offset int) int {
+	if offset < 0 {
+		return start
+	}
+	return start + offset
+}
+
+// addComparator adds the comparators meant by the operator op and the
+// version v given with n components, in the syntax of Composer if composer
+// is set.
+func (r *semverRange) addComparator(op string, v Version, n int, composer bool) {
+	if n == 0 {
+		// a wildcard is any version, and nothing is below or above it
+		if op == "<" || op == ">" || op == "!=" {
+			r.add("<", Version{Prerelease: []string{"0"}}, 0)
+		}
+		return
+	}
+	switch op {
+	case "", "=":
+		if n == 3 {
+			r.add("=", v, n)
+		} else {
+			r.add(">=", v, n)
+			r.addBelow(v, n)
+		}
+	case "!=", ">=":
+		r.add(op, v, n)
+	case ">":
+		if n == 3 {
+			r.add(">", v, n)
+		} else if next, ok := nextVersion(v, n); ok {
+			r.add(">=", next, 0)
+		} else {
+			r.add("<", Version{Prerelease: []string{"0"}}, 0)
+		}
+	case "<":
+		if n < 3 {
+			v.Prerelease, n = []string{"0"}, 0
+		}
+		r.add("<", v, n)
+	case "<=":
+		if n == 3 {
+			r.add("<=", v, n)
+		} else {
+			r.addBelow(v, n)
+		}
+	case "~":
+		r.add(">=", v, n)
+		switch {
+		case n == 1:
+			r.addBelow(v, 1)
+		case composer:
+			// the last given component may increase
+			r.addBelow(v, n-1)
+		default:
+			r.addBelow(v, 2)
+		}
+	case "^":
+		r.add(">=", v, n)
+		// the first non-zero component may not change
+		switch {
+		case v.Major > 0 || n == 1:
+			r.addBelow(v, 1)
+		case v.Minor > 0 || n == 2:
+			r.addBelow(v, 2)
+		default:
+			r.addBelow(v, 3)
+		}
+	}
+}
+
+// add adds a comparator. A version given with its 3 components and a
+// prerelease lets the range include prereleases of the same version.
+func (r *semverRange) add(op string, v Version, n int) {
+	r.comparators = append(r.comparators, semverComparator{op, v})
+	if n == 3 && len(v.Prerelease) > 0 {
+		r.prereleases = append(r.prereleases, [3]uint64{v.Major, v.Minor, v.Patch})
+	}
+}
+
+// addBelow adds a comparator for the versions below those that share the
+// first n components of v, if there are versions above them.
+func (r *semverRange) addBelow(v Version, n int) {
+	if next, ok := nextVersion(v, n); ok {
+		r.add("<", next, 0)
+	}
+}
+
+// nextVersion returns the lowest version, prereleases included, above all
+// versions that share the first n components of v. It reports false if
+// there is none, as for 18446744073709551615.x.
+func nextVersion(v Version, n int) (Version, bool) {
+	c := [3]uint64{v.Major, v.Minor, v.Patch}
+	for n > 0 && c[n-1] == math.MaxUint64 {
+		// 1.18446744073709551615.x is followed by 2.0.0-0
+		n--
+	}
+	if n == 0 {
+		return Version{}, false
+	}
+	c[n-1]++
+	for i := n; i < 3; i++ {
+		c[i] = 0
+	}
+	return Version{Major: c[0], Minor: c[1], Patch: c[2], Prerelease: []string{"0"}}, true
+}
@@ -0,0 +1,314 @@
+package is
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestParseVersion(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected Version
+	}{
+		{"1.0.0", Version{Major: 1}},
+		{"v1.2.3", Version{Major: 1, Minor: 2, Patch: 3}},
+		{"1.0.0-alpha.1", Version{Major: 1, Prerelease: []string{"alpha", "1"}}},
+		{"1.0.0-x-y.7.z.92", Version{Major: 1, Prerelease: []string{"x-y", "7", "z", "92"}}},
+		{"1.0.0-beta+exp.sha.05114f85", Version{Major: 1, Prerelease: []string{"beta"}, Build: []string{"exp", "sha", "05114f85"}}},
+		{"10.20.30+001", Version{Major: 10, Minor: 20, Patch: 30, Build: []string{"001"}}},
+		{"18446744073709551615.0.0", Version{Major: 18446744073709551615}},
+	}
+	for _, test := range tests {
+		actual, err := ParseVersion(test.param)
+		if err != nil || !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("Expected ParseVersion(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestParseVersionErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"1.2", CodeBadFormat, -1},
+		{"1.2.", CodeBadFormat, -1},
+		{"1.2-beta", CodeInvalidChar, 3},
+		{"01.1.0", CodeInvalidChar, 0},
+		{"1.1.01", CodeInvalidChar, 4},
+		{"1.x.0", CodeInvalidChar, 2},
+		{"1.0.0-03", CodeInvalidChar, 6},
+		{"1.0.0-+beta", CodeInvalidChar, 6},
+		{"1.0.0-b+-9+eta", CodeInvalidChar, 10},
+		{"1.0.0-beta..1", CodeInvalidChar, 11},
+		{"1.0.0 ", CodeInvalidChar, 5},
+		{"18446744073709551616.0.0", CodeTooLong, 0},
+		{"1.0.99999999999999999999", CodeTooLong, 4},
+	}
+	for _, test := range tests {
+		_, err := ParseVersion(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "Semver" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseVersion(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestVersionString(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{"1.2.3", "1.0.0-alpha.1", "1.0.0-beta+exp.sha.5114f85", "0.0.1+001"} {
+		v, err := ParseVersion(s)
+		if err != nil || v.String() != s {
+			t.Errorf("Expected ParseVersion(%q).String() to be %q, got %q, %v", s, s, v.String(), err)
+		}
+	}
+	if s := (Version{}).String(); s != "0.0.0" {
+		t.Errorf("Expected Version{}.String() to be %q, got %q", "0.0.0", s)
+	}
+}
+
+func TestVersionCompare(t *testing.T) {
+	t.Parallel()
+
+	// in increasing precedence, from the SemVer 2.0.0 specification
+	ordered := []string{
+		"1.0.0-alpha",
+		"1.0.0-alpha.1",
+		"1.0.0-alpha.beta",
+		"1.0.0-beta",
+		"1.0.0-beta.2",
+		"1.0.0-beta.11",
+		"1.0.0-rc.1",
+		"1.0.0",
+		"1.0.1",
+		"1.1.0",
+		"1.10.0",
+		"2.0.0",
+		"10.0.0",
+		"18446744073709551615.0.0",
+	}
+	for i, a := range ordered {
+		for j, b := range ordered {
+			va, _ := ParseVersion(a)
+			vb, _ := ParseVersion(b)
+			expected := compareUint(uint64(i), uint64(j))
+			if actual := va.Compare(vb); actual != expected {
+				t.Errorf("Expected %q.Compare(%q) to be %d, got %d", a, b, expected, actual)
+			}
+		}
+	}
+
+	a, _ := ParseVersion("1.0.0+build.1")
+	b, _ := ParseVersion("1.0.0+build.2")
+	if a.Compare(b) != 0 {
+		t.Errorf("Expected build metadata to be ignored by Compare")
+	}
+}
+
+func TestSemverSatisfies(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		version    string
+		constraint string
+		expected   bool
+	}{
+		{"1.2.3", "1.2.3", true},
+		{"1.2.3", "=1.2.3", true},
+		{"v1.2.3", "v1.2.3", true},
+		{"1.2.4", "1.2.3", false},
+		{"1.2.4", "!=1.2.3", true},
+		{"1.2.3", "!=1.2.3", false},
+		{"1.2.3", "*", true},
+		{"99999999999999999999.0.0", "*", false},
+		{"18446744073709551615.0.0", "*", true},
+		{"18446744073709551615.9.0", "^18446744073709551615.0.0", true},
+		{"18446744073709551615.0.0", ">18446744073709551615", false},
+		{"18446744073709551615.0.0", "<=18446744073709551615", true},
+		{"2.0.0", "~1.18446744073709551615", false},
+		{"1.18446744073709551615.7", "~1.18446744073709551615", true},
+		{"1.18446744073709551615.7", "1.18446744073709551615.x", true},
+		{"2.0.0", "1.18446744073709551615.x", false},
+		{"1.2.3", "18446744073709551616.x", false},
+		{"1.2.3", "x", true},
+		{"1.2.3", "1.x", true},
+		{"2.0.0", "1.x", false},
+		{"1.2.9", "1.2.x", true},
+		{"1.2.9", "1.2", true},
+		{"1.3.0", "1.2.*", false},
+		{"1.2.3", ">1.2.2", true},
+		{"1.2.3", ">1.2.3", false},
+		{"1.3.0", ">1.2", true},
+		{"1.2.9", ">1.2", false},
+		{"1.2.3", ">=1.2.3", true},
+		{"1.2.3", "<1.2.3", false},
+		{"1.1.9", "<1.2", true},
+		{"1.2.0", "<1.2", false},
+		{"1.2.9", "<=1.2", true},
+		{"1.3.0", "<=1.2", false},
+		{"1.2.3", ">= 1.2.3", true},
+		{"1.5.0", ">=1.2.3 <2.0.0", true},
+		{"1.5.0", ">=1.2.3, <2.0.0", true},
+		{"2.0.0", ">=1.2.3 <2.0.0", false},
+		{"3.4.0", ">=1.2.3 <2.0.0 || 3.x", true},
+		{"2.4.0", ">=1.2.3 <2.0.0 || 3.x", false},
+		{"3.4.0", ">=1.2.3 <2.0.0||3.x", true},
+		// tilde
+		{"1.2.3", "~1.2.3", true},
+		{"1.2.9", "~1.2.3", true},
+		{"1.3.0", "~1.2.3", false},
+		{"1.2.2", "~1.2.3", false},
+		{"2.1.0", "~2.1", true},
+		{"2.1.7", "~2.1", true},
+		{"2.2.0", "~2.1", false},
+		{"1.9.0", "~1", true},
+		{"2.0.0", "~1", false},
+		{"0.2.5", "~0.2.3", true},
+		{"0.3.0", "~0.2.3", false},
+		// caret
+		{"1.4.0", "^1.4.0", true},
+		{"1.9.9", "^1.4.0", true},
+		{"2.0.0", "^1.4.0", false},
+		{"1.3.9", "^1.4.0", false},
+		{"0.2.9", "^0.2.3", true},
+		{"0.3.0", "^0.2.3", false},
+		{"0.0.3", "^0.0.3", true},
+		{"0.0.4", "^0.0.3", false},
+		{"0.0.9", "^0.0.x", true},
+		{"0.1.0", "^0.0.x", false},
+		{"0.0.9", "^0.0", true},
+		{"0.9.0", "^0.x", true},
+		{"1.0.0", "^0.x", false},
+		{"1.9.0", "^1.2.x", true},
+		{"1.1.0", "^1.2.x", false},
+		// hyphen ranges
+		{"1.2.3", "1.2.3 - 2.3.4", true},
+		{"2.3.4", "1.2.3 - 2.3.4", true},
+		{"2.3.5", "1.2.3 - 2.3.4", false},
+		{"1.2.0", "1.2 - 2.3", true},
+		{"2.3.9", "1.2 - 2.3", true},
+		{"2.4.0", "1.2 - 2.3", false},
+		{"1.1.9", "1.2 - 2.3", false},
+		{"2.9.9", "1 - 2", true},
+		{"3.0.0", "1 - 2", false},
+		// prereleases
+		{"1.5.0-beta", "^1.4.0", false},
+		{"2.0.0-beta", "^1.4.0", false},
+		{"1.4.0-beta.2", "^1.4.0-beta.1", true},
+		{"1.4.0-alpha", "^1.4.0-beta.1", false},
+		{"1.5.0-beta", "^1.4.0-beta.1", false},
+		{"1.9.0", "^1.4.0-beta.1", true},
+		{"1.2.3-rc.1", ">=1.2.3-beta <1.3.0", true},
+		{"1.2.4-rc.1", ">=1.2.3-beta <1.3.0", false},
+		{"1.2.3-beta", "1.2.3-beta", true},
+		{"1.2.3-beta", "1.x", false},
+		{"1.2.3-beta", "*", false},
+		{"1.2.3-beta", "1.2.3-beta - 1.2.3", true},
+		// build metadata is ignored
+		{"1.2.3+build", "1.2.3", true},
+		// invalid versions or constraints
+		{"1.2", "1.x", false},
+		{"1.2.3", "", false},
+		{"1.2.3", ">=1.2.3 ||", false},
+		{"1.2.3", "1.2.3 -", false},
+		{"1.2.3", ">>1.2.3", false},
+		{"1.2.3", "!=1.2", false},
+		// a single | is Composer syntax
+		{"1.2.3", "1.2.3 | 2.0.0", false},
+	}
+	for _, test := range tests {
+		if actual := SemverSatisfies(test.version, test.constraint); actual != test.expected {
+			t.Errorf("Expected SemverSatisfies(%q, %q) to be %v, got %v", test.version, test.constraint, test.expected, actual)
+		}
+	}
+}
+
+func TestSemverSatisfiesWith(t *testing.T) {
+	t.Parallel()
+
+	composer := SemverConstraintOptions{Composer: true}
+	var tests = []struct {
+		version    string
+		constraint string
+		opts       SemverConstraintOptions
+		expected   bool
+	}{
+		{"1.9.0", "~1.2", SemverConstraintOptions{}, false},
+		{"1.9.0", "~1.2", composer, true},
+		{"1.2.0", "~1.2", composer, true},
+		{"1.1.9", "~1.2", composer, false},
+		{"2.0.0", "~1.2", composer, false},
+		{"1.2.9", "~1.2.3", composer, true},
+		{"1.3.0", "~1.2.3", composer, false},
+		{"1.9.0", "~1", composer, true},
+		{"2.0.0", "~1", composer, false},
+		{"1.5.0", "^1.2.3", composer, true},
+		{"2.0.0", "^1.2.3", composer, false},
+		{"2.0.0", "1.2.3 | 2.0.0", SemverConstraintOptions{}, false},
+		{"2.0.0", "1.2.3 | 2.0.0", composer, true},
+		{"1.2.3", "1.2.3|2.0.0", composer, true},
+		{"3.1.0", "^1.0 | ^2.0 || ^3.0", composer, true},
+		{"2.5.0", "^1.0 | ^2.0 || ^3.0", composer, true},
+		{"4.0.0", "^1.0 | ^2.0 || ^3.0", composer, false},
+		{"1.5.0", ">=1.2.3, <2.0.0", composer, true},
+		{"1.5.0-beta", "~1.2", composer, false},
+		{"1.2.3", "1.2.3 |", composer, false},
+		{"1.2.3", "| 1.2.3", composer, false},
+	}
+	for _, test := range tests {
+		if actual := SemverSatisfiesWith(test.version, test.constraint, test.opts); actual != test.expected {
+			t.Errorf("Expected SemverSatisfiesWith(%q, %q, %+v) to be %v, got %v", test.version, test.constraint, test.opts, test.expected, actual)
+		}
+	}
+}
+
+func TestParseSemverConstraintErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"  ", CodeEmpty, -1},
+		{"1.2.3 ||", CodeBadFormat, 8},
+		{"|| 1.2.3", CodeBadFormat, 0},
+		{">=1.2.3 <2.0.x0", CodeInvalidChar, 14},
+		{">=1.2.3 <2.0.0 || 3.y", CodeInvalidChar, 20},
+		{">=", CodeBadFormat, 2},
+		{"1.2.3 - 2.0.0-", CodeInvalidChar, 14},
+		{"1.2-beta", CodeBadFormat, 3},
+		{"!=1.x", CodeBadFormat, 0},
+	}
+	for _, test := range tests {
+		_, err := ParseSemverConstraint(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "SemverConstraint" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseSemverConstraint(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestParseVersionMatchesSemver(t *testing.T) {
+	t.Parallel()
+
+	for _, s := range []string{
+		"v1.0.0", "1.1.01", "v01.1.0", "1.0.0-alpha.1", "1.0.0-0.3.7", "1.0.0-0.03.7",
+		"1.0.0-alpha+001", "1.0.0-beta+exp.sha.05114f85", "1.0.0-+beta", "1.0.0-b+-9+eta",
+		"v+1.8.0-b+-9+eta", "1.2", "1.2.3.4", "V1.2.3", " 1.2.3",
+		"99999999999999999999.0.0", "1.0.0-99999999999999999999",
+	} {
+		_, err := ParseVersion(s)
+		if (err == nil) != Semver(s) {
+			t.Errorf("Expected ParseVersion(%q) to agree with Semver, got %v", s, err)
+		}
+	}
+}
@@ -0,0 +1,495 @@
+package is
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// structTag is the name of the struct tag read by Struct.
+const structTag = "is"
+
+// stringRules maps tag rule names to the string predicates they dispatch to.
+var stringRules = map[string]func(string) bool{
+	"alpha":            Alpha,
+	"utfletter":        UTFLetter,
+	"alphanumeric":     Alphanumeric,
+	"utfletternumeric": UTFLetterNumeric,
+	"numeric":          Numeric,
+	"utfnumeric":       UTFNumeric,
+	"utfdigit":         UTFDigit,
+	"hexadecimal":      Hexadecimal,
+	"hexcolor":         Hexcolor,
+	"rgbcolor":         RGBcolor,
+	"lowercase":        LowerCase,
+	"uppercase":        UpperCase,
+	"int":              Int,
+	"float":            Float,
+	"email":            Email,
+	"url":              URL,
+	"requesturl":       RequestURL,
+	"requesturi":       RequestURI,
+	"uuid":             UUID,
+	"uuidv1":           UUIDv1,
+	"uuidv2":           UUIDv2,
+	"uuidv3":           UUIDv3,
+	"uuidv4":           UUIDv4,
+	"uuidv5":           UUIDv5,
+	"uuidv6":           UUIDv6,
+	"uuidv7":           UUIDv7,
+	"uuidv8":           UUIDv8,
+	"uuidnil":          UUIDNil,
+	"uuidmax":          UUIDMax,
+	"creditcard":       CreditCard,
+	"isbn10":           ISBN10,
+	"isbn13":           ISBN13,
+	"ean8":             EAN8,
+	"ean13":            EAN13,
+	"upca":             UPCA,
+	"upce":             UPCE,
+	"gtin":             GTIN,
+	"sscc":             SSCC,
+	"issn":             ISSN,
+	"ismn":             ISMN,
+	"iban":             IBAN,
+	"isin":             ISIN,
+	"cusip":            CUSIP,
+	"sedol":            SEDOL,
+	"lei":              LEI,
+	"figi":             FIGI,
+	"bic":              BIC,
+	"abarouting":       ABARouting,
+	"bsb":              BSB,
+	"sortcode":         SortCode,
+	"catransit":        CATransit,
+	"phonee164":        PhoneE164,
+	"vatnumber":        VATNumber,
+	"json":             JSON,
+	"multibyte":        Multibyte,
+	"ascii":            ASCII,
+	"printableascii":   PrintableASCII,
+	"fullwidth":        FullWidth,
+	"halfwidth":        HalfWidth,
+	"variablewidth":    VariableWidth,
+	"base64":           Base64,
+	"datauri":          DataURI,
+	"iso3166alpha2":    ISO3166Alpha2,
+	"iso3166alpha3":    ISO3166Alpha3,
+	"iso4217":          ISO4217,
+	"iso4217numeric":   ISO4217Numeric,
+	"iso6391":          ISO6391,
+	"iso6392":          ISO6392,
+	"iso6393":          ISO6393,
+	"languagetag":      LanguageTag,
+	"dnsname":          DNSName,
+	"dialstring":       DialString,
+	"ip":               IP,
+	"ipv4":             IPv4,
+	"ipv6":             IPv6,
+	"publicip":         PublicIP,
+	"privateip":        PrivateIP,
+	"cidr":             CIDR,
+	"cidrv4":           CIDRv4,
+	"cidrv6":           CIDRv6,
+	"cidrstrict":       CIDRStrict,
+	"iprange":          IPRange,
+	"port":             Port,
+	"mac":              MAC,
+	"mongoid":          MongoID,
+	"ulid":             ULID,
+	"ksuid":            KSUID,
+	"xid":              XID,
+	"snowflake":        Snowflake,
+	"nanoid":           NanoID,
+	"cuid2":            CUID2,
+	"latitude":         Latitude,
+	"longitude":        Longitude,
+	"ssn":              SSN,
+	"tckn":             TCKN,
+	"vkn":              VKN,
+	"trplate":          TRPlate,
+	"sin":              SIN,
+	"nino":             NINO,
+	"aadhaar":          Aadhaar,
+	"cpf":              CPF,
+	"cnpj":             CNPJ,
+	"dni":              DNI,
+	"nie":              NIE,
+	"codicefiscale":    CodiceFiscale,
+	"cnresidentid":     CNResidentID,
+	"semver":           Semver,
+	"gomodulepath":     GoModulePath,
+	"goimportpath":     GoImportPath,
+	"gopseudoversion":  GoPseudoVersion,
+}
+
+// FieldError describes a struct field that failed one of its rules.
+type FieldError struct {
+	// Field is the Go path of the value, e.g. Address.Lines[2].
+	Field string
+	// Rule is the failing rule as written in the tag, e.g. length=3|64.
+	Rule string
+}
+
+func (e *FieldError) Error() string {
+	return e.Field + ": failed rule " + strconv.Quote(e.Rule)
+}
+
+// StructError lists every field of a struct that failed validation.
+type StructError []*FieldError
+
+func (e StructError) Error() string {
+	msgs := make([]string, len(e))
+	for i, fe := range e {
+		msgs[i] = fe.Error()
+	}
+	return strings.Join(msgs, "; ")
+}
+
+type rule struct {
+	name string
+	args []string
+	tag  string
+}
+
+// Struct validates the exported fields of a struct according to their
+// `is:"..."` tags and returns a StructError listing every failing field.
+//
+// A tag is a comma separated list of rules, e.g. `is:"required,email"` or
+// `is:"length=3|64"`. A rule is the lowercased name of a string predicate
+// (email, url, uuidv4, ...) or one of the parameterized rules below;
+// parameters are separated by "|".
+//
+//	required            value must not be zero (or empty, for slices and maps)
+//	length=min|max      StringLength
+//	bytelength=min|max  ByteLength
+//	isbn=version        ISBN
+//	phone=region        Phone
+//	postalcode=country  PostalCode
+//	nationalid=country  NationalID
+//	calver=format       CalVer
+//	range=left|right    InRange, for numeric fields
+//	whole, natural      Whole and Natural, for numeric fields
+//
+// Empty values are only checked by required. Nested structs, pointers and
+// interfaces are followed, and the rules of a slice, array or map field are
+// applied to each of its elements. A tag of "-" skips the field. Values
+// shared by several fields are validated at each of them, but a pointer, map
+// or slice that leads back to one of its ancestors, as in cyclic data
+// structures, is not followed again.
+func Struct(v interface{}) error {
+	rv := reflect.ValueOf(v)
+	ancestors := make(map[visit]bool)
+	for rv.Kind() == reflect.Ptr {
+		if rv.IsNil() {
+			return errors.New("is: Struct called with a nil pointer")
+		}
+		enter(rv, ancestors)
+		rv = rv.Elem()
+	}
+	if rv.Kind() != reflect.Struct {
+		return fmt.Errorf("is: Struct called with non-struct type %T", v)
+	}
+
+	var errs StructError
+	if err := validateStruct(rv, "", ancestors, &errs); err != nil {
+		return err
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// visit identifies a pointer, map or slice on the path being validated, so
+// that cycles are not walked forever. The type tells apart a struct and its
+// first field, which share their address.
+type visit struct {
+	typ reflect.Type
+	ptr uintptr
+	len int
+}
+
+func validateStruct(v reflect.Value, path string, ancestors map[visit]bool, errs *StructError) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		if f.PkgPath != "" && !f.Anonymous {
+			continue // unexported
+		}
+		tag := f.Tag.Get(structTag)
+		if tag == "-" {
+			continue
+		}
+		rules, err := parseRules(tag)
+		if err != nil {
+			return fmt.Errorf("is: field %s: %v", joinPath(path, f.Name), err)
+		}
+
+		name := joinPath(path, f.Name)
+		if f.Anonymous {
+			// promoted fields keep the path of the embedding struct
+			name = path
+		}
+		if err := validateValue(v.Field(i), name, rules, ancestors, errs); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validateValue(v reflect.Value, path string, rules []rule, ancestors map[visit]bool, errs *StructError) error {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			if r, ok := findRule(rules, "required"); ok {
+				*errs = append(*errs, &FieldError{Field: path, Rule: r.tag})
+			}
+			return nil
+		}
+		if v.Kind() == reflect.Ptr {
+			k, ok := enter(v, ancestors)
+			if !ok {
+				return nil
+			}
+			defer delete(ancestors, k)
+		}
+		v = v.Elem()
+	}
+
+	switch v.Kind() {
+	case reflect.Struct:
+		if v.IsZero() {
+			if r, ok := findRule(rules, "required"); ok {
+				*errs = append(*errs, &FieldError{Field: path, Rule: r.tag})
+			}
+		}
+		return validateStruct(v, path, ancestors, errs)
+	case reflect.Slice, reflect.Array, reflect.Map:
+		if v.Len() == 0 {
+			if r, ok := findRule(rules, "required"); ok {
+				*errs = append(*errs, &FieldError{Field: path, Rule: r.tag})
+			}
+			return nil
+		}
+		if v.Kind() != reflect.Array {
+			k, ok := enter(v, ancestors)
+			if !ok {
+				return nil
+			}
+			defer delete(ancestors, k)
+		}
+		elemRules := withoutRule(rules, "required")
+		if v.Kind() == reflect.Map {
+			// visit keys in a stable order so errors are reported deterministically
+			keys := v.MapKeys()
+			names := make([]string, len(keys))
+			for i, k := range keys {
+				names[i] = fmt.Sprint(k)
+			}
+			sort.Sort(mapKeys{keys, names})
+			for i, k := range keys {
+				p := path + "[" + names[i] + "]"
+				if err := validateValue(v.MapIndex(k), p, elemRules, ancestors, errs); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+		for i := 0; i < v.Len(); i++ {
+			p := path + "[" + strconv.Itoa(i) + "]"
+			if err := validateValue(v.Index(i), p, elemRules, ancestors, errs); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+
+	if v.IsZero() {
+		if r, ok := findRule(rules, "required"); ok {
+			*errs = append(*errs, &FieldError{Field: path, Rule: r.tag})
+		}
+		return nil
+	}
+
+	for _, r := range rules {
+		if r.name == "required" {
+			continue
+		}
+		ok, err := checkRule(v, r)
+		if err != nil {
+			return fmt.Errorf("is: field %s: %v", path, err)
+		}
+		if !ok {
+			*errs = append(*errs, &FieldError{Field: path, Rule: r.tag})
+		}
+	}
+	return nil
+}
+
+// enter adds the pointer, map or slice v to ancestors and returns its key,
+// or reports false if v is already one of them.
+func enter(v reflect.Value, ancestors map[visit]bool) (visit, bool) {
+	k := visit{v.Type(), v.Pointer(), 0}
+	if v.Kind() == reflect.Slice {
+		k.len = v.Len()
+	}
+	if ancestors[k] {
+		return k, false
+	}
+	ancestors[k] = true
+	return k, true
+}
+
+// checkRule applies a single rule to a non-zero scalar value.
+func checkRule(v reflect.Value, r rule) (bool, error) {
+	switch v.Kind() {
+	case reflect.String:
+		return checkStringRule(v.String(), r)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return checkNumberRule(float64(v.Int()), r)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return checkNumberRule(float64(v.Uint()), r)
+	case reflect.Float32, reflect.Float64:
+		return checkNumberRule(v.Float(), r)
+	}
+	return false, fmt.Errorf("rule %q does not apply to %s values", r.name, v.Kind())
+}
+
+func checkStringRule(s string, r rule) (bool, error) {
+	if fn, ok := stringRules[r.name]; ok {
+		if len(r.args) != 0 {
+			return false, fmt.Errorf("rule %q takes no parameters", r.name)
+		}
+		return fn(s), nil
+	}
+
+	switch r.name {
+	case "length", "bytelength":
+		if len(r.args) != 2 {
+			return false, fmt.Errorf("rule %q needs min|max parameters", r.name)
+		}
+		min, err1 := strconv.Atoi(r.args[0])
+		max, err2 := strconv.Atoi(r.args[1])
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("rule %q has non-integer parameters", r.name)
+		}
+		if r.name == "length" {
+			return StringLength(s, min, max), nil
+		}
+		return ByteLength(s, min, max), nil
+	case "isbn":
+		version := -1
+		if len(r.args) == 1 {
+			i, err := strconv.Atoi(r.args[0])
+			if err != nil {
+				return false, fmt.Errorf("rule %q has a non-integer parameter", r.name)
+			}
+			version = i
+		}
+		return ISBN(s, version), nil
+	case "phone":
+		if len(r.args) != 1 {
+			return false, fmt.Errorf("rule %q needs a region parameter", r.name)
+		}
+		return Phone(s, r.args[0]), nil
+	case "postalcode":
+		if len(r.args) != 1 {
+			return false, fmt.Errorf("rule %q needs a country parameter", r.name)
+		}
+		return PostalCode(s, r.args[0]), nil
+	case "nationalid":
+		if len(r.args) != 1 {
+			return false, fmt.Errorf("rule %q needs a country parameter", r.name)
+		}
+		return NationalID(r.args[0], s), nil
+	case "calver":
+		if len(r.args) != 1 {
+			return false, fmt.Errorf("rule %q needs a format parameter", r.name)
+		}
+		return CalVer(s, r.args[0]), nil
+	}
+	return false, fmt.Errorf("unknown rule %q for string values", r.name)
+}
+
+func checkNumberRule(f float64, r rule) (bool, error) {
+	switch r.name {
+	case "range":
+		if len(r.args) != 2 {
+			return false, fmt.Errorf("rule %q needs left|right parameters", r.name)
+		}
+		left, err1 := strconv.ParseFloat(r.args[0], 64)
+		right, err2 := strconv.ParseFloat(r.args[1], 64)
+		if err1 != nil || err2 != nil {
+			return false, fmt.Errorf("rule %q has non-numeric parameters", r.name)
+		}
+		return InRange(f, left, right), nil
+	case "whole":
+		return Whole(f), nil
+	case "natural":
+		return Natural(f), nil
+	}
+	return false, fmt.Errorf("unknown rule %q for numeric values", r.name)
+}
+
+// parseRules splits a tag such as "required,length=3|64" into rules.
+func parseRules(tag string) ([]rule, error) {
+	if tag == "" {
+		return nil, nil
+	}
+	var rules []rule
+	for _, part := range strings.Split(tag, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			return nil, fmt.Errorf("empty rule in tag %q", tag)
+		}
+		r := rule{name: part, tag: part}
+		if i := strings.IndexByte(part, '='); i >= 0 {
+			r.name = part[:i]
+			r.args = strings.Split(part[i+1:], "|")
+		}
+		rules = append(rules, r)
+	}
+	return rules, nil
+}
+
+func findRule(rules []rule, name string) (rule, bool) {
+	for _, r := range rules {
+		if r.name == name {
+			return r, true
+		}
+	}
+	return rule{}, false
+}
+
+func withoutRule(rules []rule, name string) []rule {
+	var out []rule
+	for _, r := range rules {
+		if r.name != name {
+			out = append(out, r)
+		}
+	}
+	return out
+}
+
+// mapKeys sorts map keys by their formatted names.
+type mapKeys struct {
+	keys  []reflect.Value
+	names []string
+}
+
+func (m mapKeys) Len() int           { return len(m.keys) }
+func (m mapKeys) Less(i, j int) bool { return m.names[i] < m.names[j] }
+func (m mapKeys) Swap(i, j int) {
+	m.keys[i], m.keys[j] = m.keys[j], m.keys[i]
+	m.names[i], m.names[j] = m.names[j], m.names[i]
+}
+
+func joinPath(path, name string) string {
+	if path == "" {
+		return name
+	}
+	return path + "." + name
+}
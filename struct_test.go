@@ -0,0 +1,170 @@
+package is
+
+import (
+	"reflect"
+	"testing"
+)
+
+type testAddress struct {
+	Street     string   `is:"required,length=3|64"`
+	Lines      []string `is:"required,length=1|8"`
+	Zip        string   `is:"numeric"`
+	PostalCode string   `is:"postalcode=TR"`
+}
+
+type testUser struct {
+	Email   string `is:"required,email"`
+	Website string `is:"url"`
+	Phone   string `is:"phone=GB"`
+	Age     int    `is:"range=18|130"`
+	Address testAddress
+	Billing *testAddress      `is:"required"`
+	Tags    map[string]string `is:"alpha"`
+	Ignored string            `is:"-"`
+	secret  string
+}
+
+func TestStruct(t *testing.T) {
+	t.Parallel()
+
+	valid := testUser{
+		Email:   "foo@bar.com",
+		Phone:   "020 7946 0018",
+		Age:     42,
+		Address: testAddress{Street: "Main", Lines: []string{"a", "b"}, Zip: "34000", PostalCode: "34000"},
+		Billing: &testAddress{Street: "Side", Lines: []string{"c"}},
+		Tags:    map[string]string{"x": "abc"},
+		Ignored: "not checked",
+		secret:  "not checked",
+	}
+
+	var tests = []struct {
+		param    func(u *testUser)
+		expected []FieldError
+	}{
+		{func(u *testUser) {}, nil},
+		{func(u *testUser) { u.Email = "" }, []FieldError{{"Email", "required"}}},
+		{func(u *testUser) { u.Email = "invalid.com" }, []FieldError{{"Email", "email"}}},
+		{func(u *testUser) { u.Website = "foo" }, []FieldError{{"Website", "url"}}},
+		{func(u *testUser) { u.Phone = "+1 415 555 2671" }, nil},
+		{func(u *testUser) { u.Phone = "555 2671" }, []FieldError{{"Phone", "phone=GB"}}},
+		{func(u *testUser) { u.Age = 7 }, []FieldError{{"Age", "range=18|130"}}},
+		{func(u *testUser) { u.Billing = nil }, []FieldError{{"Billing", "required"}}},
+		{func(u *testUser) { u.Billing.Lines = nil }, []FieldError{{"Billing.Lines", "required"}}},
+		{func(u *testUser) { u.Address.Lines = []string{"a", "b", "too long line"} }, []FieldError{{"Address.Lines[2]", "length=1|8"}}},
+		{func(u *testUser) { u.Tags = map[string]string{"b": "b2", "a": "a1"} }, []FieldError{{"Tags[a]", "alpha"}, {"Tags[b]", "alpha"}}},
+		{
+			func(u *testUser) {
+				u.Email = "@invalid.com"
+				u.Address.Street = "ab"
+				u.Address.Zip = "x1"
+			},
+			[]FieldError{{"Email", "email"}, {"Address.Street", "length=3|64"}, {"Address.Zip", "numeric"}},
+		},
+		{func(u *testUser) { u.Address.PostalCode = "3400" }, []FieldError{{"Address.PostalCode", "postalcode=TR"}}},
+	}
+	for i, test := range tests {
+		u := valid
+		billing := *valid.Billing
+		u.Billing = &billing
+		test.param(&u)
+
+		err := Struct(&u)
+		if test.expected == nil {
+			if err != nil {
+				t.Errorf("Expected Struct(case %d) to succeed, got %v", i, err)
+			}
+			continue
+		}
+		actual, ok := err.(StructError)
+		if !ok {
+			t.Errorf("Expected Struct(case %d) to return StructError, got %v", i, err)
+			continue
+		}
+		var got []FieldError
+		for _, fe := range actual {
+			got = append(got, *fe)
+		}
+		if !reflect.DeepEqual(got, test.expected) {
+			t.Errorf("Expected Struct(case %d) to fail with %v, got %v", i, test.expected, got)
+		}
+	}
+}
+
+func TestStructInvalidUse(t *testing.T) {
+	t.Parallel()
+
+	var tests = []interface{}{
+		nil,
+		"foo",
+		(*testUser)(nil),
+		struct {
+			Name string `is:"nosuchrule"`
+		}{"foo"},
+		struct {
+			Name string `is:"length=3"`
+		}{"foo"},
+		struct {
+			Phone string `is:"phone"`
+		}{"foo"},
+		struct {
+			Age int `is:"email"`
+		}{1},
+	}
+	for _, test := range tests {
+		err := Struct(test)
+		if err == nil {
+			t.Errorf("Expected Struct(%#v) to return an error", test)
+			continue
+		}
+		if _, ok := err.(StructError); ok {
+			t.Errorf("Expected Struct(%#v) to report a usage error, got %v", test, err)
+		}
+	}
+}
+
+type testNode struct {
+	Name     string `is:"alpha"`
+	Parent   *testNode
+	Children []*testNode
+	Links    map[string]interface{}
+}
+
+func TestStructCycle(t *testing.T) {
+	t.Parallel()
+
+	root := &testNode{Name: "root1"}
+	child := &testNode{Name: "child1", Parent: root}
+	root.Children = []*testNode{child, root}
+	root.Links = map[string]interface{}{"self": root, "children": root.Children}
+	child.Links = map[string]interface{}{"root": root.Links}
+
+	// the child is reached twice without a cycle, the root only once
+	expected := []FieldError{{"Name", "alpha"}, {"Children[0].Name", "alpha"}, {"Links[children][0].Name", "alpha"}}
+	err := Struct(root)
+	actual, ok := err.(StructError)
+	if !ok || len(actual) != len(expected) {
+		t.Fatalf("Expected Struct of a cyclic graph to fail with %v, got %v", expected, err)
+	}
+	for i := range expected {
+		if *actual[i] != expected[i] {
+			t.Errorf("Expected error %d to be %v, got %v", i, expected[i], *actual[i])
+		}
+	}
+}
+
+func TestStructSharedPointer(t *testing.T) {
+	t.Parallel()
+
+	s := "foo"
+	v := struct {
+		A *string `is:"email"`
+		B *string `is:"url"`
+	}{&s, &s}
+
+	err := Struct(&v)
+	actual, ok := err.(StructError)
+	if !ok || len(actual) != 2 || *actual[0] != (FieldError{"A", "email"}) || *actual[1] != (FieldError{"B", "url"}) {
+		t.Errorf("Expected Struct to check both fields sharing a pointer, got %v", err)
+	}
+}
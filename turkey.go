@@ -0,0 +1,103 @@
+package is
+
+// TCKN check if the string is a Turkish identity number (T.C. Kimlik
+// Numarası): 11 digits not starting with 0, where the 10th digit is
+// 7 times the sum of the odd-positioned digits minus the sum of the
+// even-positioned digits among the first nine, modulo 10, and the 11th digit
+// is the sum of the first ten digits modulo 10.
+func TCKN(str string) bool {
+	if len(str) != 11 || str[0] == '0' || !Numeric(str) {
+		return false
+	}
+	var odd, even int
+	for i := 0; i < 9; i++ {
+		d := int(str[i] - '0')
+		if i%2 == 0 {
+			odd += d
+		} else {
+			even += d
+		}
+	}
+	d10 := ((odd*7-even)%10 + 10) % 10
+	if int(str[9]-'0') != d10 {
+		return false
+	}
+	return int(str[10]-'0') == (odd+even+d10)%10
+}
+
+// VKN check if the string is a Turkish tax identification number (Vergi
+// Kimlik Numarası) of 10 digits. Each of the first nine digits d at index i
+// (from 0) is turned into v = (d + 9 - i) mod 10, then v·2^(9-i) mod 9 with 9
+// in place of a non-zero v that yields 0; the last digit makes the sum of
+// these values a multiple of 10.
+func VKN(str string) bool {
+	if len(str) != 10 || !Numeric(str) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 9; i++ {
+		v := (int(str[i]-'0') + 9 - i) % 10
+		if v != 0 {
+			v = (v << uint(9-i)) % 9
+			if v == 0 {
+				v = 9
+			}
+		}
+		sum += v
+	}
+	return int(str[9]-'0') == (10-sum%10)%10
+}
+
+// TRPlate check if the string is a Turkish vehicle registration plate: a
+// province code from 01 to 81, then 1 letter and 4 or 5 digits, 2 letters and
+// 3 or 4 digits, or 3 letters and 2 or 3 digits, as in 34 ABC 123. The groups
+// may be separated by single spaces and letters may be lower case; the
+// letters Q, W and X, which are not in the Turkish alphabet, are not used.
+// Special series such as diplomatic plates are not accepted.
+func TRPlate(str string) bool {
+	i := 0
+	// span returns the length of the run of characters at i accepted by fn
+	span := func(fn func(c byte) bool) int {
+		n := 0
+		for i+n < len(str) && fn(str[i+n]) {
+			n++
+		}
+		return n
+	}
+	isDigit := func(c byte) bool { return '0' <= c && c <= '9' }
+	isLetter := func(c byte) bool {
+		c |= 0x20
+		return 'a' <= c && c <= 'z' && c != 'q' && c != 'w' && c != 'x'
+	}
+	skipSpace := func() {
+		if i < len(str) && str[i] == ' ' {
+			i++
+		}
+	}
+
+	if span(isDigit) != 2 {
+		return false
+	}
+	if province := int(str[0]-'0')*10 + int(str[1]-'0'); province < 1 || province > 81 {
+		return false
+	}
+	i += 2
+	skipSpace()
+	letters := span(isLetter)
+	i += letters
+	skipSpace()
+	digits := span(isDigit)
+	i += digits
+	if i != len(str) {
+		return false
+	}
+	switch letters {
+	case 1:
+		return digits == 4 || digits == 5
+	case 2:
+		return digits == 3 || digits == 4
+	case 3:
+		return digits == 2 || digits == 3
+	}
+	return false
+}
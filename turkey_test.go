@@ -0,0 +1,90 @@
+package is
+
+import "testing"
+
+func TestTCKN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"10000000146", true},
+		{"11111111110", true},
+		{"10000000147", false},
+		{"10000000156", false},
+		{"01000000146", false},
+		{"1000000014", false},
+		{"100000001460", false},
+		{"1000000014a", false},
+		{"1000 000 0146", false},
+	}
+	for _, test := range tests {
+		if actual := TCKN(test.param); actual != test.expected {
+			t.Errorf("Expected TCKN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestVKN(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"1234567890", true},
+		{"9876543217", true},
+		{"4730056198", true},
+		{"0000000001", true},
+		{"1234567891", false},
+		{"9876543210", false},
+		{"123456789", false},
+		{"12345678900", false},
+		{"123456789a", false},
+	}
+	for _, test := range tests {
+		if actual := VKN(test.param); actual != test.expected {
+			t.Errorf("Expected VKN(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestTRPlate(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"34 ABC 123", true},
+		{"34ABC123", true},
+		{"34 abc 123", true},
+		{"34 ABC 12", true},
+		{"06 AB 123", true},
+		{"06 AB 1234", true},
+		{"01 A 1234", true},
+		{"81 A 12345", true},
+		{"34 ABC 1234", false},
+		{"34 AB 12", false},
+		{"34 A 123", false},
+		{"34 ABCD 12", false},
+		{"34 123", false},
+		{"00 ABC 123", false},
+		{"82 ABC 123", false},
+		{"3 ABC 123", false},
+		{"34 AQC 123", false},
+		{"34 AWX 123", false},
+		{"34  ABC 123", false},
+		{"34-ABC-123", false},
+		{"34 ABC 123 ", false},
+	}
+	for _, test := range tests {
+		if actual := TRPlate(test.param); actual != test.expected {
+			t.Errorf("Expected TRPlate(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
@@ -0,0 +1,269 @@
+package is
+
+import (
+	"net"
+	"net/url"
+	"strconv"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// URLOptions is a policy for URLWith. The zero value accepts http and https
+// URLs of up to 2082 bytes with a host name, and rejects IP hosts, localhost
+// and userinfo.
+type URLOptions struct {
+	// AllowedSchemes lists the accepted schemes, case-insensitively.
+	// Nil means http and https.
+	AllowedSchemes []string
+	// RequireHost rejects URLs without a host, such as mailto:foo@bar.com.
+	// URLs of the http, https, ftp, ws and wss schemes always need a host.
+	RequireHost bool
+	// RequireTLD rejects host names without an alphabetic top-level domain, such as localhost.
+	RequireTLD bool
+	// AllowIP accepts IP addresses as host. Hosts whose last label is a
+	// number, such as 127.1, 0x7f.0.0.1 or 2130706433, are IPv4 addresses as
+	// browsers read them, and are rejected if they are not valid ones.
+	AllowIP bool
+	// AllowPrivateIP accepts the localhost name and, if AllowIP is set,
+	// addresses that are not globally reachable (see PublicIP).
+	AllowPrivateIP bool
+	// AllowUserinfo accepts a user name and password before the host.
+	AllowUserinfo bool
+	// AllowedPorts lists the accepted explicit ports. Nil means any port.
+	AllowedPorts []int
+	// MaxLength is the maximum length in bytes. Zero means 2082.
+	MaxLength int
+}
+
+var defaultURLSchemes = []string{"http", "https"}
+
+// hierarchicalURLSchemes are the schemes whose URLs are meaningless without a host.
+var hierarchicalURLSchemes = []string{"http", "https", "ftp", "ws", "wss"}
+
+// URLWith check if the string is an absolute URL that complies with opts.
+func URLWith(str string, opts URLOptions) bool {
+	return CheckURLWith(str, opts) == nil
+}
+
+// CheckURLWith is like URLWith but returns a *ValidationError whose Code names the violated policy.
+func CheckURLWith(str string, opts URLOptions) error {
+	maxLength := opts.MaxLength
+	if maxLength == 0 {
+		maxLength = 2082
+	}
+	switch {
+	case str == "":
+		return invalid("URL", CodeEmpty, -1)
+	case len(str) > maxLength:
+		return invalid("URL", CodeTooLong, -1)
+	}
+
+	u, err := url.Parse(str)
+	if err != nil {
+		return invalid("URL", CodeBadFormat, -1)
+	}
+
+	schemes := opts.AllowedSchemes
+	if schemes == nil {
+		schemes = defaultURLSchemes
+	}
+	if !containsFold(schemes, u.Scheme) {
+		return invalid("URL", CodeBadScheme, 0)
+	}
+
+	if u.User != nil && !opts.AllowUserinfo {
+		return invalid("URL", CodeUserinfo, len(u.Scheme)+3)
+	}
+
+	host := u.Hostname()
+	if host == "" {
+		// http:foo and http:///foo have no host, unlike mailto:foo@bar.com
+		if opts.RequireHost || containsFold(hierarchicalURLSchemes, u.Scheme) {
+			return invalid("URL", CodeMissingHost, -1)
+		}
+		return nil
+	}
+	hostOffset, authorityEnd := authorityBounds(str)
+	if strings.HasPrefix(str[hostOffset:], "[") {
+		hostOffset++
+	}
+
+	ip := parseZonedIP(host)
+	if ip == nil && hostEndsInNumber(host) {
+		// browsers read such hosts as IPv4 addresses, e.g. 127.1 or 0x7f000001
+		if ip = parseLegacyIPv4(host); ip == nil {
+			return invalid("URL", CodeBadFormat, hostOffset)
+		}
+	}
+	if ip != nil {
+		switch {
+		case !opts.AllowIP:
+			return invalid("URL", CodeIPHost, hostOffset)
+		case !opts.AllowPrivateIP && !globalIP(ip):
+			return invalid("URL", CodePrivateIP, hostOffset)
+		}
+	} else {
+		// a fully qualified name may end with the dot of the root
+		host = strings.TrimSuffix(host, ".")
+		if len(host)-strings.Count(host, ".") > 255 {
+			return invalid("URL", CodeTooLong, hostOffset)
+		}
+		if code, offset := checkHostName(host); code != "" {
+			return invalid("URL", code, hostOffset+offset)
+		}
+		lower := strings.ToLower(host)
+		if !opts.AllowPrivateIP && (lower == "localhost" || strings.HasSuffix(lower, ".localhost")) {
+			return invalid("URL", CodePrivateIP, hostOffset)
+		}
+		if opts.RequireTLD {
+			i := strings.LastIndexByte(host, '.')
+			if i < 0 || utf8.RuneCountInString(host[i+1:]) < 2 || !UTFLetter(host[i+1:]) {
+				return invalid("URL", CodeMissingTLD, hostOffset)
+			}
+		}
+	}
+
+	if p := u.Port(); p != "" {
+		portOffset := authorityEnd - len(p)
+		if !Port(p) {
+			return invalid("URL", CodeBadPort, portOffset)
+		}
+		if opts.AllowedPorts != nil {
+			n, _ := strconv.Atoi(p)
+			if !containsInt(opts.AllowedPorts, n) {
+				return invalid("URL", CodeBadPort, portOffset)
+			}
+		}
+	}
+	return nil
+}
+
+// authorityBounds returns the offsets of the host and of the end of the authority in
+// a URL known to have one.
+func authorityBounds(str string) (host, end int) {
+	host = strings.Index(str, "//") + 2
+	end = len(str)
+	if i := strings.IndexAny(str[host:], "/?#"); i >= 0 {
+		end = host + i
+	}
+	if i := strings.LastIndexByte(str[host:end], '@'); i >= 0 {
+		host += i + 1
+	}
+	return host, end
+}
+
+// checkHostName checks the labels of a host name: 1 to 63 bytes of letters
+// and digits of any script, combining marks and inner hyphens, which is the
+// letter-digit-hyphen rule of RFC 5321 extended to internationalized labels.
+// Underscores are not allowed. It returns the code and offset of the first
+// error, or an empty code.
+func checkHostName(host string) (code string, offset int) {
+	start := 0
+	for i, r := range host + "." {
+		if r == '.' {
+			switch {
+			case i == start:
+				return CodeBadFormat, i
+			case i-start > 63:
+				return CodeTooLong, start
+			case host[i-1] == '-':
+				return CodeInvalidChar, i - 1
+			}
+			start = i + 1
+			continue
+		}
+		if !unicode.IsLetter(r) && !unicode.IsDigit(r) && !unicode.Is(unicode.Mn, r) &&
+			(r != '-' || i == start) {
+			return CodeInvalidChar, i
+		}
+	}
+	return "", 0
+}
+
+// parseZonedIP parses an IP address that may carry an IPv6 zone, as in fe80::1%eth0.
+func parseZonedIP(host string) net.IP {
+	if i := strings.IndexByte(host, '%'); i >= 0 {
+		host = host[:i]
+	}
+	return net.ParseIP(host)
+}
+
+// hostEndsInNumber reports whether the last label of host, ignoring a
+// trailing dot, is a decimal, octal or hexadecimal number, which makes it an
+// IPv4 address under WHATWG URL host parsing.
+func hostEndsInNumber(host string) bool {
+	host = strings.TrimSuffix(host, ".")
+	last := host[strings.LastIndexByte(host, '.')+1:]
+	if last == "" {
+		return false
+	}
+	digits := "0123456789"
+	if len(last) >= 2 && last[0] == '0' && (last[1] == 'x' || last[1] == 'X') {
+		last, digits = last[2:], "0123456789abcdefABCDEF"
+	}
+	for i := 0; i < len(last); i++ {
+		if strings.IndexByte(digits, last[i]) < 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// parseLegacyIPv4 parses host as an IPv4 address of 1 to 4 decimal, octal
+// (leading 0) or hexadecimal (leading 0x) parts, the last part filling the
+// remaining bytes, as WHATWG URL host parsing does. It returns nil if host is
+// not such an address.
+func parseLegacyIPv4(host string) net.IP {
+	parts := strings.Split(strings.TrimSuffix(host, "."), ".")
+	if len(parts) > 4 {
+		return nil
+	}
+	var addr uint64
+	for i, part := range parts {
+		base := 10
+		switch {
+		case len(part) >= 2 && part[0] == '0' && (part[1] == 'x' || part[1] == 'X'):
+			part, base = part[2:], 16
+			if part == "" {
+				part = "0"
+			}
+		case len(part) >= 2 && part[0] == '0':
+			part, base = part[1:], 8
+		}
+		n, err := strconv.ParseUint(part, base, 32)
+		if err != nil || part == "" {
+			return nil
+		}
+		if i < len(parts)-1 {
+			if n > 255 {
+				return nil
+			}
+			addr |= n << uint(8*(3-i))
+		} else {
+			if n >= 1<<uint(8*(4-i)) {
+				return nil
+			}
+			addr |= n
+		}
+	}
+	return net.IPv4(byte(addr>>24), byte(addr>>16), byte(addr>>8), byte(addr))
+}
+
+func containsFold(list []string, s string) bool {
+	for _, v := range list {
+		if strings.EqualFold(v, s) {
+			return true
+		}
+	}
+	return false
+}
+
+func containsInt(list []int, n int) bool {
+	for _, v := range list {
+		if v == n {
+			return true
+		}
+	}
+	return false
+}
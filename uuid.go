@@ -0,0 +1,250 @@
+package is
+
+import (
+	"strings"
+	"time"
+)
+
+// UUIDVariant is the variant of a UUID, which tells how to read the rest of
+// its bits.
+type UUIDVariant int
+
+// UUID variants, from the high bits of the 9th byte.
+const (
+	// UUIDVariantNCS is reserved for backward compatibility with NCS UUIDs
+	// (0xxx), and is the variant of the nil UUID.
+	UUIDVariantNCS UUIDVariant = iota
+	// UUIDVariantRFC9562 is the variant of RFC 9562 (10xx), the one of the
+	// UUID versions 1 to 8.
+	UUIDVariantRFC9562
+	// UUIDVariantMicrosoft is reserved for Microsoft GUIDs (110x).
+	UUIDVariantMicrosoft
+	// UUIDVariantFuture is reserved for future use (111x), and is the
+	// variant of the max UUID.
+	UUIDVariantFuture
+)
+
+// UUIDOptions lists the forms of a UUID accepted by ParseUUID besides the
+// canonical lower case form, a987fbc9-4bed-3078-8f07-9141ba07c9f3.
+type UUIDOptions struct {
+	// AllowUpperCase accepts upper case hexadecimal digits.
+	AllowUpperCase bool
+	// AllowBraces accepts the UUID between braces, as in
+	// {a987fbc9-4bed-3078-8f07-9141ba07c9f3}.
+	AllowBraces bool
+	// AllowURN accepts the URN form, as in
+	// urn:uuid:a987fbc9-4bed-3078-8f07-9141ba07c9f3.
+	AllowURN bool
+	// AllowCompact accepts the 32 hexadecimal digits without hyphens, also
+	// within braces or in a URN if those are allowed.
+	AllowCompact bool
+}
+
+// UUIDParts holds a parsed UUID.
+type UUIDParts struct {
+	// Bytes are the 16 bytes of the UUID.
+	Bytes [16]byte
+	// Version is the version of an RFC 9562 UUID, from 1 to 8, 0 for the
+	// nil UUID and 15 for the max UUID, and 0 for other variants.
+	Version int
+	// Variant is the variant of the UUID.
+	Variant UUIDVariant
+}
+
+// Time returns the time embedded in a UUID of version 1, 6 or 7, with a
+// resolution of 100 nanoseconds for versions 1 and 6 and of a millisecond
+// for version 7. It returns false for other UUIDs.
+func (p UUIDParts) Time() (time.Time, bool) {
+	b := p.Bytes
+	var ts uint64
+	switch p.Version {
+	case 1:
+		ts = uint64(b[6]&0x0f)<<56 | uint64(b[7])<<48 | uint64(b[4])<<40 | uint64(b[5])<<32 |
+			uint64(b[0])<<24 | uint64(b[1])<<16 | uint64(b[2])<<8 | uint64(b[3])
+	case 6:
+		ts = uint64(b[0])<<52 | uint64(b[1])<<44 | uint64(b[2])<<36 | uint64(b[3])<<28 |
+			uint64(b[4])<<20 | uint64(b[5])<<12 | uint64(b[6]&0x0f)<<8 | uint64(b[7])
+	case 7:
+		return unixMilli(int64(bigEndian(b[:6]))), true
+	default:
+		return time.Time{}, false
+	}
+	// versions 1 and 6 count 100 nanosecond intervals from 1582-10-15
+	const gregorianToUnix = 0x01b21dd213814000
+	t := int64(ts) - gregorianToUnix
+	return time.Unix(t/1e7, t%1e7*100).UTC(), true
+}
+
+// uuidMax is the max UUID, with all bits set.
+var uuidMax = [16]byte{
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+	0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff,
+}
+
+// UUID check if the string is a UUID of any version or variant in the
+// canonical form of 32 lower case hexadecimal digits grouped 8-4-4-4-12 by
+// hyphens. Use ParseUUID to accept other forms.
+func UUID(str string) bool {
+	_, code, _ := parseUUID(str, UUIDOptions{})
+	return code == ""
+}
+
+// UUIDVersion returns the version of an RFC 9562 UUID in the canonical form
+// of UUID: 1 to 8 for the UUID versions, whose variant bits must be 10, 0
+// for the nil UUID and 15 for the max UUID. It returns false for strings that
+// are not UUIDs and UUIDs of other variants or of undefined versions.
+func UUIDVersion(str string) (int, bool) {
+	u, code, _ := parseUUID(str, UUIDOptions{})
+	if code != "" {
+		return 0, false
+	}
+	p := uuidParts(u)
+	return p.Version, p.Version != 0 || u == [16]byte{}
+}
+
+// uuidIs reports whether str is a canonical UUID of the version.
+func uuidIs(str string, version int) bool {
+	v, ok := UUIDVersion(str)
+	return ok && v == version
+}
+
+// UUIDv1 check if the string is a UUID version 1 (Gregorian time and node).
+func UUIDv1(str string) bool {
+	return uuidIs(str, 1)
+}
+
+// UUIDv2 check if the string is a UUID version 2 (DCE security).
+func UUIDv2(str string) bool {
+	return uuidIs(str, 2)
+}
+
+// UUIDv3 check if the string is a UUID version 3 (MD5 name-based).
+func UUIDv3(str string) bool {
+	return uuidIs(str, 3)
+}
+
+// UUIDv4 check if the string is a UUID version 4 (random).
+func UUIDv4(str string) bool {
+	return uuidIs(str, 4)
+}
+
+// UUIDv5 check if the string is a UUID version 5 (SHA-1 name-based).
+func UUIDv5(str string) bool {
+	return uuidIs(str, 5)
+}
+
+// UUIDv6 check if the string is a UUID version 6 (reordered Gregorian time).
+func UUIDv6(str string) bool {
+	return uuidIs(str, 6)
+}
+
+// UUIDv7 check if the string is a UUID version 7 (Unix time in milliseconds).
+func UUIDv7(str string) bool {
+	return uuidIs(str, 7)
+}
+
+// UUIDv8 check if the string is a UUID version 8 (custom).
+func UUIDv8(str string) bool {
+	return uuidIs(str, 8)
+}
+
+// UUIDNil check if the string is the nil UUID, with all bits cleared.
+func UUIDNil(str string) bool {
+	return str == "00000000-0000-0000-0000-000000000000"
+}
+
+// UUIDMax check if the string is the max UUID, with all bits set.
+func UUIDMax(str string) bool {
+	return str == "ffffffff-ffff-ffff-ffff-ffffffffffff"
+}
+
+// UUIDWith check if the string is a UUID of any version or variant in the
+// canonical form or one of the forms allowed by opts.
+func UUIDWith(str string, opts UUIDOptions) bool {
+	_, code, _ := parseUUID(str, opts)
+	return code == ""
+}
+
+// ParseUUID parses a UUID in the canonical form or one of the forms allowed
+// by opts. On failure the error is a *ValidationError.
+func ParseUUID(str string, opts UUIDOptions) (UUIDParts, error) {
+	u, code, offset := parseUUID(str, opts)
+	if code != "" {
+		return UUIDParts{}, invalid("UUID", code, offset)
+	}
+	return uuidParts(u), nil
+}
+
+func uuidParts(u [16]byte) UUIDParts {
+	p := UUIDParts{Bytes: u}
+	switch {
+	case u[8]&0x80 == 0:
+		p.Variant = UUIDVariantNCS
+	case u[8]&0xc0 == 0x80:
+		p.Variant = UUIDVariantRFC9562
+	case u[8]&0xe0 == 0xc0:
+		p.Variant = UUIDVariantMicrosoft
+	default:
+		p.Variant = UUIDVariantFuture
+	}
+	switch {
+	case u == uuidMax:
+		p.Version = 15
+	case p.Variant == UUIDVariantRFC9562:
+		if v := int(u[6] >> 4); 1 <= v && v <= 8 {
+			p.Version = v
+		}
+	}
+	return p
+}
+
+// parseUUID decodes str without allocating. It returns the error code and
+// offset, or "" if str is valid.
+func parseUUID(str string, opts UUIDOptions) ([16]byte, string, int) {
+	var u [16]byte
+	if str == "" {
+		return u, CodeEmpty, -1
+	}
+	start, end := 0, len(str)
+	switch {
+	case opts.AllowBraces && str[0] == '{':
+		if str[end-1] != '}' {
+			return u, CodeInvalidChar, end - 1
+		}
+		start, end = 1, end-1
+	case opts.AllowURN && len(str) > 9 && strings.EqualFold(str[:9], "urn:uuid:"):
+		start = 9
+	}
+	hyphens := end-start == 36
+	if !hyphens && (!opts.AllowCompact || end-start != 32) {
+		return u, CodeBadLength, -1
+	}
+
+	n := 0
+	for i := start; i < end; i++ {
+		c := str[i]
+		if j := i - start; hyphens && (j == 8 || j == 13 || j == 18 || j == 23) {
+			if c != '-' {
+				return u, CodeInvalidChar, i
+			}
+			continue
+		}
+		var v byte
+		switch {
+		case '0' <= c && c <= '9':
+			v = c - '0'
+		case 'a' <= c && c <= 'f':
+			v = c - 'a' + 10
+		case 'A' <= c && c <= 'F' && opts.AllowUpperCase:
+			v = c - 'A' + 10
+		default:
+			return u, CodeInvalidChar, i
+		}
+		if n%2 == 0 {
+			v <<= 4
+		}
+		u[n/2] |= v
+		n++
+	}
+	return u, "", 0
+}
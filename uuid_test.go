@@ -0,0 +1,197 @@
+package is
+
+import (
+	"testing"
+	"time"
+)
+
+func TestUUIDVersion(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param   string
+		version int
+		ok      bool
+	}{
+		{"c232ab00-9414-11ec-b3c8-9f6bdeced846", 1, true},
+		{"000003e8-9414-21ec-b300-9f6bdeced846", 2, true},
+		{"5df41881-3aed-3515-88a7-2f4a814cf09e", 3, true},
+		{"919108f7-52d1-4320-9bac-f847db4148a8", 4, true},
+		{"2ed6657d-e927-568b-95e1-2665a8aea6a2", 5, true},
+		{"1ec9414c-232a-6b00-b3c8-9f6bdeced846", 6, true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", 7, true},
+		{"2489e9ad-2ee2-8e00-8ec9-32d5f69181c0", 8, true},
+		{"00000000-0000-0000-0000-000000000000", 0, true},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", 15, true},
+		// variants other than RFC 9562
+		{"919108f7-52d1-4320-1bac-f847db4148a8", 0, false},
+		{"919108f7-52d1-4320-cbac-f847db4148a8", 0, false},
+		{"919108f7-52d1-4320-ebac-f847db4148a8", 0, false},
+		// undefined versions
+		{"919108f7-52d1-0320-9bac-f847db4148a8", 0, false},
+		{"919108f7-52d1-9320-9bac-f847db4148a8", 0, false},
+		{"919108f7-52d1-f320-9bac-f847db4148a8", 0, false},
+		// only the canonical form
+		{"919108F7-52D1-4320-9BAC-F847DB4148A8", 0, false},
+		{"{919108f7-52d1-4320-9bac-f847db4148a8}", 0, false},
+		{"919108f752d143209bacf847db4148a8", 0, false},
+		{"", 0, false},
+	}
+	for _, test := range tests {
+		version, ok := UUIDVersion(test.param)
+		if version != test.version || ok != test.ok {
+			t.Errorf("Expected UUIDVersion(%q) to be %d, %v, got %d, %v", test.param, test.version, test.ok, version, ok)
+		}
+	}
+}
+
+func TestUUIDVersions(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		function func(string) bool
+		name     string
+		param    string
+		expected bool
+	}{
+		{UUIDv1, "UUIDv1", "c232ab00-9414-11ec-b3c8-9f6bdeced846", true},
+		{UUIDv1, "UUIDv1", "c232ab00-9414-11ec-73c8-9f6bdeced846", false},
+		{UUIDv1, "UUIDv1", "1ec9414c-232a-6b00-b3c8-9f6bdeced846", false},
+		{UUIDv2, "UUIDv2", "000003e8-9414-21ec-b300-9f6bdeced846", true},
+		{UUIDv2, "UUIDv2", "c232ab00-9414-11ec-b3c8-9f6bdeced846", false},
+		{UUIDv6, "UUIDv6", "1ec9414c-232a-6b00-b3c8-9f6bdeced846", true},
+		{UUIDv6, "UUIDv6", "1ec9414c-232a-6b00-f3c8-9f6bdeced846", false},
+		{UUIDv7, "UUIDv7", "017f22e2-79b0-7cc3-98c4-dc0c0c07398f", true},
+		{UUIDv7, "UUIDv7", "017f22e2-79b0-7cc3-d8c4-dc0c0c07398f", false},
+		{UUIDv7, "UUIDv7", "017F22E2-79B0-7CC3-98C4-DC0C0C07398F", false},
+		{UUIDv8, "UUIDv8", "2489e9ad-2ee2-8e00-8ec9-32d5f69181c0", true},
+		{UUIDv8, "UUIDv8", "2489e9ad-2ee2-8e00-0ec9-32d5f69181c0", false},
+		{UUIDNil, "UUIDNil", "00000000-0000-0000-0000-000000000000", true},
+		{UUIDNil, "UUIDNil", "00000000-0000-0000-0000-000000000001", false},
+		{UUIDMax, "UUIDMax", "ffffffff-ffff-ffff-ffff-ffffffffffff", true},
+		{UUIDMax, "UUIDMax", "FFFFFFFF-FFFF-FFFF-FFFF-FFFFFFFFFFFF", false},
+		{UUID, "UUID", "919108f7-52d1-f320-ebac-f847db4148a8", true},
+	}
+	for _, test := range tests {
+		if actual := test.function(test.param); actual != test.expected {
+			t.Errorf("Expected %s(%q) to be %v, got %v", test.name, test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestUUIDWith(t *testing.T) {
+	t.Parallel()
+
+	all := UUIDOptions{AllowUpperCase: true, AllowBraces: true, AllowURN: true, AllowCompact: true}
+	var tests = []struct {
+		param    string
+		opts     UUIDOptions
+		expected bool
+	}{
+		{"919108f7-52d1-4320-9bac-f847db4148a8", UUIDOptions{}, true},
+		{"919108F7-52D1-4320-9BAC-F847DB4148A8", UUIDOptions{}, false},
+		{"919108F7-52D1-4320-9BAC-F847DB4148A8", UUIDOptions{AllowUpperCase: true}, true},
+		{"{919108f7-52d1-4320-9bac-f847db4148a8}", UUIDOptions{}, false},
+		{"{919108f7-52d1-4320-9bac-f847db4148a8}", UUIDOptions{AllowBraces: true}, true},
+		{"{919108f7-52d1-4320-9bac-f847db4148a8", UUIDOptions{AllowBraces: true}, false},
+		{"urn:uuid:919108f7-52d1-4320-9bac-f847db4148a8", UUIDOptions{}, false},
+		{"urn:uuid:919108f7-52d1-4320-9bac-f847db4148a8", UUIDOptions{AllowURN: true}, true},
+		{"URN:UUID:919108f7-52d1-4320-9bac-f847db4148a8", UUIDOptions{AllowURN: true}, true},
+		{"919108f752d143209bacf847db4148a8", UUIDOptions{}, false},
+		{"919108f752d143209bacf847db4148a8", UUIDOptions{AllowCompact: true}, true},
+		{"{919108F752D143209BACF847DB4148A8}", all, true},
+		{"urn:uuid:{919108f7-52d1-4320-9bac-f847db4148a8}", all, false},
+		{"919108f7-52d1-4320-9bac-f847db4148a", all, false},
+		{"", all, false},
+	}
+	for _, test := range tests {
+		if actual := UUIDWith(test.param, test.opts); actual != test.expected {
+			t.Errorf("Expected UUIDWith(%q, %+v) to be %v, got %v", test.param, test.opts, test.expected, actual)
+		}
+	}
+}
+
+func TestParseUUID(t *testing.T) {
+	t.Parallel()
+
+	p, err := ParseUUID("{919108F7-52D1-4320-9BAC-F847DB4148A8}", UUIDOptions{AllowUpperCase: true, AllowBraces: true})
+	if err != nil {
+		t.Fatalf("Expected ParseUUID to succeed, got %v", err)
+	}
+	want := [16]byte{0x91, 0x91, 0x08, 0xf7, 0x52, 0xd1, 0x43, 0x20, 0x9b, 0xac, 0xf8, 0x47, 0xdb, 0x41, 0x48, 0xa8}
+	if p.Bytes != want || p.Version != 4 || p.Variant != UUIDVariantRFC9562 {
+		t.Errorf("Expected ParseUUID to return %x, 4, RFC 9562, got %x, %d, %d", want, p.Bytes, p.Version, p.Variant)
+	}
+
+	var variants = []struct {
+		param   string
+		version int
+		variant UUIDVariant
+	}{
+		{"00000000-0000-0000-0000-000000000000", 0, UUIDVariantNCS},
+		{"919108f7-52d1-4320-1bac-f847db4148a8", 0, UUIDVariantNCS},
+		{"919108f7-52d1-4320-cbac-f847db4148a8", 0, UUIDVariantMicrosoft},
+		{"919108f7-52d1-4320-ebac-f847db4148a8", 0, UUIDVariantFuture},
+		{"ffffffff-ffff-ffff-ffff-ffffffffffff", 15, UUIDVariantFuture},
+	}
+	for _, test := range variants {
+		p, err := ParseUUID(test.param, UUIDOptions{})
+		if err != nil || p.Version != test.version || p.Variant != test.variant {
+			t.Errorf("Expected ParseUUID(%q) to be version %d, variant %d, got %d, %d, %v", test.param, test.version, test.variant, p.Version, p.Variant, err)
+		}
+	}
+}
+
+func TestParseUUIDErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		opts   UUIDOptions
+		code   string
+		offset int
+	}{
+		{"", UUIDOptions{}, CodeEmpty, -1},
+		{"919108f7-52d1-4320-9bac-f847db4148a", UUIDOptions{}, CodeBadLength, -1},
+		{"919108f752d143209bacf847db4148a8", UUIDOptions{}, CodeBadLength, -1},
+		{"919108f7_52d1-4320-9bac-f847db4148a8", UUIDOptions{}, CodeInvalidChar, 8},
+		{"919108f7-52d1-4320-9bag-f847db4148a8", UUIDOptions{}, CodeInvalidChar, 22},
+		{"919108F7-52d1-4320-9bac-f847db4148a8", UUIDOptions{}, CodeInvalidChar, 6},
+		{"{919108f7-52d1-4320-9bac-f847db4148a8)", UUIDOptions{AllowBraces: true}, CodeInvalidChar, 37},
+		{"urn:uuid:919108f7-52d1-4320-9bac-f847db4148ax", UUIDOptions{AllowURN: true}, CodeInvalidChar, 44},
+	}
+	for _, test := range tests {
+		_, err := ParseUUID(test.param, test.opts)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "UUID" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseUUID(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}
+
+func TestUUIDTime(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected time.Time
+		ok       bool
+	}{
+		{"c232ab00-9414-11ec-b3c8-9f6bdeced846", time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC), true},
+		{"1ec9414c-232a-6b00-b3c8-9f6bdeced846", time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC), true},
+		{"017f22e2-79b0-7cc3-98c4-dc0c0c07398f", time.Date(2022, 2, 22, 19, 22, 22, 0, time.UTC), true},
+		{"00000000-0000-1000-8000-000000000000", time.Date(1582, 10, 15, 0, 0, 0, 0, time.UTC), true},
+		{"919108f7-52d1-4320-9bac-f847db4148a8", time.Time{}, false},
+		{"00000000-0000-0000-0000-000000000000", time.Time{}, false},
+	}
+	for _, test := range tests {
+		p, err := ParseUUID(test.param, UUIDOptions{})
+		if err != nil {
+			t.Fatalf("Expected ParseUUID(%q) to succeed, got %v", test.param, err)
+		}
+		actual, ok := p.Time()
+		if !actual.Equal(test.expected) || ok != test.ok {
+			t.Errorf("Expected Time of %q to be %v, %v, got %v, %v", test.param, test.expected, test.ok, actual, ok)
+		}
+	}
+}
@@ -0,0 +1,521 @@
+package is
+
+import (
+	"strings"
+	"time"
+
+	"github.com/alioygur/is/checkdigit"
+)
+
+// VATEntry stores the VAT identification number formats of a country
+type VATEntry struct {
+	// Prefix starts the VAT number. It is the Country except for EL
+	// (Greece), XI (Northern Ireland) and CHE (Switzerland).
+	Prefix string
+	// Country is the Alpha2Code of the country in ISO3166List.
+	Country string
+	// Format lists the accepted formats of the number after the prefix,
+	// separated by "|", in the syntax of PostalCodeEntry.
+	Format string
+}
+
+// VATList based on the VAT number formats published by the European
+// Commission for VIES, plus the United Kingdom, Norway and Switzerland
+var VATList = []VATEntry{
+	{"AT", "AT", "U########"},
+	{"BE", "BE", "##########"},
+	{"BG", "BG", "#########|##########"},
+	{"CHE", "CH", "#########MWST|#########TVA|#########IVA|#########TPV"},
+	{"CY", "CY", "########@"},
+	{"CZ", "CZ", "########|#########|##########"},
+	{"DE", "DE", "#########"},
+	{"DK", "DK", "########"},
+	{"EE", "EE", "#########"},
+	{"EL", "GR", "#########"},
+	{"ES", "ES", "*#######*"},
+	{"FI", "FI", "########"},
+	{"FR", "FR", "**#########"},
+	{"GB", "GB", "#########|############|GD###|HA###"},
+	{"HR", "HR", "###########"},
+	{"HU", "HU", "########"},
+	{"IE", "IE", "#######@|#######@@|#@#####@"},
+	{"IT", "IT", "###########"},
+	{"LT", "LT", "#########|############"},
+	{"LU", "LU", "########"},
+	{"LV", "LV", "###########"},
+	{"MT", "MT", "########"},
+	{"NL", "NL", "#########B##"},
+	{"NO", "NO", "#########MVA"},
+	{"PL", "PL", "##########"},
+	{"PT", "PT", "#########"},
+	{"RO", "RO", "##|###|####|#####|######|#######|########|#########|##########"},
+	{"SE", "SE", "##########01"},
+	{"SI", "SI", "########"},
+	{"SK", "SK", "##########"},
+	{"XI", "GB", "#########|############|GD###|HA###"},
+}
+
+// vatChecks maps the prefixes of VATList to the check of the number after
+// the prefix, which is already known to match one of the formats.
+var vatChecks = map[string]func(string) bool{
+	"AT":  vatAT,
+	"BE":  vatBE,
+	"BG":  vatBG,
+	"CHE": vatCH,
+	"CY":  vatCY,
+	"CZ":  vatCZ,
+	"DE":  vatDE,
+	"DK":  vatDK,
+	"EE":  vatEE,
+	"EL":  vatEL,
+	"ES":  vatES,
+	"FI":  vatFI,
+	"FR":  vatFR,
+	"GB":  vatGB,
+	"HR":  vatHR,
+	"HU":  vatHU,
+	"IE":  vatIE,
+	"IT":  vatIT,
+	"LT":  vatLT,
+	"LU":  vatLU,
+	"LV":  vatLV,
+	"MT":  vatMT,
+	"NL":  vatNL,
+	"NO":  vatNO,
+	"PL":  vatPL,
+	"PT":  vatPT,
+	"RO":  vatRO,
+	"SE":  vatSE,
+	"SI":  vatSI,
+	"SK":  vatSK,
+	"XI":  vatGB,
+}
+
+// vatMaxLength bounds the length of a VAT number without separators.
+const vatMaxLength = 16
+
+// VATNumberParts holds the components of a VAT identification number.
+type VATNumberParts struct {
+	// Prefix is the prefix of the number as listed in VATList, e.g. EL.
+	Prefix string
+	// CountryCode is the ISO 3166 alpha-2 code of the country, e.g. GR.
+	CountryCode string
+	// Number is the upper case number after the prefix without separators.
+	Number string
+}
+
+// VATNumber check if the string is a VAT identification number of a country
+// in VATList, such as DE136695976 or CHE-116.281.710 MWST: a prefix, which is
+// EL rather than GR for Greece, followed by a number in one of the country's
+// formats that passes its check digit algorithm. Spaces, dots and hyphens are
+// ignored and letters may be lower case. Whether the number is actually
+// registered can only be told by VIES or the national registry.
+func VATNumber(str string) bool {
+	_, err := ParseVATNumber(str)
+	return err == nil
+}
+
+// ParseVATNumber validates a VAT identification number like VATNumber and
+// returns its parts. On failure the error is a *ValidationError whose
+// offsets refer to str.
+func ParseVATNumber(str string) (VATNumberParts, error) {
+	if str == "" {
+		return VATNumberParts{}, invalid("VATNumber", CodeEmpty, -1)
+	}
+
+	// upper case copy of str without separators
+	var buf [vatMaxLength]byte
+	n := 0
+	for i := 0; i < len(str); i++ {
+		c := str[i]
+		if c == ' ' || c == '.' || c == '-' {
+			continue
+		}
+		if 'a' <= c && c <= 'z' {
+			c -= 'a' - 'A'
+		}
+		if ('Z' < c || c < 'A') && ('9' < c || c < '0') {
+			return VATNumberParts{}, invalid("VATNumber", CodeInvalidChar, i)
+		}
+		if n == len(buf) {
+			return VATNumberParts{}, invalid("VATNumber", CodeTooLong, i)
+		}
+		buf[n] = c
+		n++
+	}
+	if n < 2 {
+		return VATNumberParts{}, invalid("VATNumber", CodeTooShort, -1)
+	}
+
+	e := vatEntry(string(buf[:n]))
+	if e == nil {
+		return VATNumberParts{}, invalid("VATNumber", CodeUnknownCountry, -1)
+	}
+	number := buf[len(e.Prefix):n]
+	for _, format := range strings.Split(e.Format, "|") {
+		if _, ok := applyFormat(format, number); ok {
+			parts := VATNumberParts{e.Prefix, e.Country, string(number)}
+			if !vatChecks[e.Prefix](parts.Number) {
+				return VATNumberParts{}, invalid("VATNumber", CodeBadChecksum, -1)
+			}
+			return parts, nil
+		}
+	}
+	return VATNumberParts{}, invalid("VATNumber", CodeBadFormat, -1)
+}
+
+// vatEntry returns the VATList entry whose prefix starts the compact upper
+// case VAT number, or nil.
+func vatEntry(str string) *VATEntry {
+	for i := range VATList {
+		if strings.HasPrefix(str, VATList[i].Prefix) {
+			return &VATList[i]
+		}
+	}
+	return nil
+}
+
+// weightedSum returns the sum of the ASCII digits of str multiplied by the
+// weights in order.
+func weightedSum(str string, weights ...int) int {
+	sum := 0
+	for i, w := range weights {
+		sum += int(str[i]-'0') * w
+	}
+	return sum
+}
+
+// mod11Check returns 11 minus the remainder of sum modulo 11, with 0 in
+// place of 11 and -1, which never matches a digit, in place of 10.
+func mod11Check(sum int) int {
+	switch r := 11 - sum%11; r {
+	case 10:
+		return -1
+	case 11:
+		return 0
+	default:
+		return r
+	}
+}
+
+// digitAt returns the value of the ASCII digit of str at i.
+func digitAt(str string, i int) int {
+	return int(str[i] - '0')
+}
+
+func vatAT(n string) bool {
+	sum := 0
+	for i := 1; i < 8; i++ {
+		d := digitAt(n, i)
+		if i%2 == 0 {
+			d *= 2
+			d = d/10 + d%10
+		}
+		sum += d
+	}
+	return digitAt(n, 8) == (96-sum)%10
+}
+
+func vatBE(n string) bool {
+	base := 0
+	for i := 0; i < 8; i++ {
+		base = base*10 + digitAt(n, i)
+	}
+	return n[0] <= '1' && 97-base%97 == digitAt(n, 8)*10+digitAt(n, 9)
+}
+
+func vatBG(n string) bool {
+	if len(n) == 9 {
+		// legal entities
+		r := weightedSum(n, 1, 2, 3, 4, 5, 6, 7, 8) % 11
+		if r == 10 {
+			r = weightedSum(n, 3, 4, 5, 6, 7, 8, 9, 10) % 11 % 10
+		}
+		return digitAt(n, 8) == r
+	}
+	// persons (EGN), foreigners (LNCh) and other taxpayers
+	check := digitAt(n, 9)
+	return weightedSum(n, 2, 4, 8, 5, 10, 9, 7, 3, 6)%11%10 == check ||
+		weightedSum(n, 21, 19, 17, 13, 11, 9, 7, 3, 1)%10 == check ||
+		mod11Check(weightedSum(n, 4, 3, 2, 7, 6, 5, 4, 3, 2)) == check
+}
+
+func vatCH(n string) bool {
+	return mod11Check(weightedSum(n, 5, 4, 3, 2, 7, 6, 5, 4)) == digitAt(n, 8)
+}
+
+func vatCY(n string) bool {
+	if strings.IndexByte("013459", n[0]) < 0 || n[:2] == "12" {
+		return false
+	}
+	// the digits at odd positions are valued as in a codice fiscale
+	sum := 0
+	for i := 0; i < 8; i++ {
+		if i%2 == 0 {
+			sum += codiceFiscaleOdd[n[i]-'0']
+		} else {
+			sum += digitAt(n, i)
+		}
+	}
+	return n[8] == byte('A'+sum%26)
+}
+
+func vatCZ(n string) bool {
+	switch len(n) {
+	case 8:
+		// legal entities
+		if n[0] == '9' {
+			return false
+		}
+		check := 11 - weightedSum(n, 8, 7, 6, 5, 4, 3, 2)%11
+		return digitAt(n, 7) == check%10
+	case 9:
+		// individuals born before 1954, with no check digit
+		_, err := time.Parse("060102", n[:2]+czBirthMonth(n[2:4])+n[4:6])
+		return err == nil
+	}
+	// individuals: the birth number modulo 11, with 0 for 10
+	if _, err := time.Parse("060102", n[:2]+czBirthMonth(n[2:4])+n[4:6]); err != nil {
+		return false
+	}
+	r := 0
+	for i := 0; i < 9; i++ {
+		r = (r*10 + digitAt(n, i)) % 11
+	}
+	return digitAt(n, 9) == r%10
+}
+
+// czBirthMonth removes the offsets added to the month of a Czech birth number
+// for women (50) and for numbers issued from 2004 (20).
+func czBirthMonth(mm string) string {
+	m := int(mm[0]-'0')*10 + int(mm[1]-'0')
+	if m > 50 {
+		m -= 50
+	}
+	if m > 20 {
+		m -= 20
+	}
+	return string([]byte{byte('0' + m/10), byte('0' + m%10)})
+}
+
+func vatDE(n string) bool {
+	return n[0] != '0' && checkdigit.Mod11Hybrid10{}.Validate(n)
+}
+
+func vatDK(n string) bool {
+	return n[0] != '0' && weightedSum(n, 2, 7, 6, 5, 4, 3, 2, 1)%11 == 0
+}
+
+func vatEE(n string) bool {
+	return n[:2] == "10" && digitAt(n, 8) == (10-weightedSum(n, 3, 7, 1, 3, 7, 1, 3, 7)%10)%10
+}
+
+func vatEL(n string) bool {
+	return digitAt(n, 8) == weightedSum(n, 256, 128, 64, 32, 16, 8, 4, 2)%11%10
+}
+
+func vatES(n string) bool {
+	switch c := n[0]; {
+	case '0' <= c && c <= '9':
+		return DNI(n)
+	case c == 'X' || c == 'Y' || c == 'Z':
+		return NIE(n)
+	case c == 'K' || c == 'L' || c == 'M':
+		// Spaniards without DNI
+		return dniLetter(n[1:8]) == n[8]
+	case strings.IndexByte("ABCDEFGHJNPQRSUVW", c) >= 0:
+		// legal entities (CIF), whose check is a digit for some entity
+		// types, a letter for others and either for the rest
+		sum := 0
+		for i := 1; i < 8; i++ {
+			d := digitAt(n, i)
+			if i%2 == 1 {
+				d *= 2
+				d = d/10 + d%10
+			}
+			sum += d
+		}
+		check := (10 - sum%10) % 10
+		digit, letter := byte('0'+check), "JABCDEFGHI"[check]
+		switch {
+		case strings.IndexByte("ABEH", c) >= 0:
+			return n[8] == digit
+		case strings.IndexByte("NPQRSW", c) >= 0:
+			return n[8] == letter
+		}
+		return n[8] == digit || n[8] == letter
+	}
+	return false
+}
+
+func vatFI(n string) bool {
+	r := weightedSum(n, 7, 9, 10, 5, 8, 4, 2) % 11
+	if r == 0 {
+		return n[7] == '0'
+	}
+	return r != 1 && digitAt(n, 7) == 11-r
+}
+
+func vatFR(n string) bool {
+	if !Numeric(n[:2]) {
+		// alphanumeric keys of numbers issued to newly registered
+		// businesses are not checked, but never use I or O
+		return strings.IndexAny(n[:2], "IO") < 0
+	}
+	siren := 0
+	for i := 2; i < 11; i++ {
+		siren = (siren*10 + digitAt(n, i)) % 97
+	}
+	return digitAt(n, 0)*10+digitAt(n, 1) == (12+3*siren)%97
+}
+
+func vatGB(n string) bool {
+	switch {
+	case n[:2] == "GD":
+		// government departments
+		return n[2] <= '4'
+	case n[:2] == "HA":
+		// health authorities
+		return n[2] >= '5'
+	}
+	// the check covers the first 9 digits; 12 digits add a branch
+	if n[:7] == "0000000" {
+		return false
+	}
+	sum := weightedSum(n, 8, 7, 6, 5, 4, 3, 2) + digitAt(n, 7)*10 + digitAt(n, 8)
+	return sum%97 == 0 || (sum+55)%97 == 0
+}
+
+func vatHR(n string) bool {
+	return checkdigit.Mod11Hybrid10{}.Validate(n)
+}
+
+func vatHU(n string) bool {
+	return digitAt(n, 7) == (10-weightedSum(n, 9, 7, 3, 1, 9, 7, 3)%10)%10
+}
+
+func vatIE(n string) bool {
+	if len(n) == 8 && n[1] >= 'A' {
+		// old format: the second character is a letter, and the digits
+		// are checked as 0, the next five and the first
+		n = "0" + n[2:7] + n[:1] + n[7:]
+	}
+	sum := weightedSum(n, 8, 7, 6, 5, 4, 3, 2)
+	if len(n) == 9 {
+		i := strings.IndexByte("WABCDEFGHI", n[8])
+		if i < 0 {
+			return false
+		}
+		sum += 9 * i
+	}
+	return n[7] == "WABCDEFGHIJKLMNOPQRSTUV"[sum%23]
+}
+
+func vatIT(n string) bool {
+	office := digitAt(n, 7)*100 + digitAt(n, 8)*10 + digitAt(n, 9)
+	if (office < 1 || office > 100) && office != 120 && office != 121 && office != 888 && office != 999 {
+		return false
+	}
+	return checkdigit.Luhn{}.Validate(n)
+}
+
+func vatLT(n string) bool {
+	// the digit before the check digit is 1 for VAT payers
+	k := len(n) - 1
+	if n[k-1] != '1' {
+		return false
+	}
+	r := 0
+	for i := 0; i < k; i++ {
+		r += digitAt(n, i) * (1 + i%9)
+	}
+	if r %= 11; r == 10 {
+		r = 0
+		for i := 0; i < k; i++ {
+			r += digitAt(n, i) * (1 + (i+2)%9)
+		}
+		r = r % 11 % 10
+	}
+	return digitAt(n, k) == r
+}
+
+func vatLU(n string) bool {
+	base := 0
+	for i := 0; i < 6; i++ {
+		base = base*10 + digitAt(n, i)
+	}
+	return base%89 == digitAt(n, 6)*10+digitAt(n, 7)
+}
+
+func vatLV(n string) bool {
+	if n[0] <= '3' {
+		// natural persons: a personal code starting with 32 or with the
+		// birth date as DDMMYY
+		if n[:2] == "32" {
+			return true
+		}
+		_, err := time.Parse("020106", n[:6])
+		return err == nil
+	}
+	r := 3 - weightedSum(n, 9, 1, 4, 8, 3, 10, 2, 5, 7, 6)%11
+	if r < -1 {
+		r += 11
+	}
+	return digitAt(n, 10) == r
+}
+
+func vatMT(n string) bool {
+	return n[0] != '0' && 37-weightedSum(n, 3, 4, 6, 7, 8, 9)%37 == digitAt(n, 6)*10+digitAt(n, 7)
+}
+
+func vatNL(n string) bool {
+	if weightedSum(n, 9, 8, 7, 6, 5, 4, 3, 2)%11 == digitAt(n, 8) {
+		return true
+	}
+	// numbers of sole proprietors issued from 2020 pass ISO 7064 MOD 97-10
+	// over the whole number including the NL prefix
+	return checkdigit.Mod97Radix10{}.Validate("NL" + n)
+}
+
+func vatNO(n string) bool {
+	return mod11Check(weightedSum(n, 3, 2, 7, 6, 5, 4, 3, 2)) == digitAt(n, 8)
+}
+
+func vatPL(n string) bool {
+	return digitAt(n, 9) == weightedSum(n, 6, 5, 7, 2, 3, 4, 5, 6, 7)%11
+}
+
+func vatPT(n string) bool {
+	check := mod11Check(weightedSum(n, 9, 8, 7, 6, 5, 4, 3, 2))
+	if check < 0 {
+		check = 0
+	}
+	return n[0] != '0' && digitAt(n, 8) == check
+}
+
+func vatRO(n string) bool {
+	// the weights are aligned to the right of the check digit
+	weights := []int{7, 5, 3, 2, 1, 7, 5, 3, 2}
+	k := len(n) - 1
+	return n[0] != '0' && digitAt(n, k) == weightedSum(n, weights[len(weights)-k:]...)*10%11%10
+}
+
+func vatSE(n string) bool {
+	return checkdigit.Luhn{}.Validate(n[:10])
+}
+
+func vatSI(n string) bool {
+	r := 11 - weightedSum(n, 8, 7, 6, 5, 4, 3, 2)%11
+	return n[0] != '0' && r != 11 && digitAt(n, 7) == r%10
+}
+
+func vatSK(n string) bool {
+	if n[0] == '0' || strings.IndexByte("234789", n[2]) < 0 {
+		return false
+	}
+	r := 0
+	for i := 0; i < len(n); i++ {
+		r = (r*10 + digitAt(n, i)) % 11
+	}
+	return r == 0
+}
@@ -0,0 +1,170 @@
+package is
+
+import "testing"
+
+func TestVATList(t *testing.T) {
+	t.Parallel()
+
+	for _, e := range VATList {
+		if !ISO3166Alpha2(e.Country) {
+			t.Errorf("VATList entry %s is not in ISO3166List", e.Prefix)
+		}
+		if vatChecks[e.Prefix] == nil {
+			t.Errorf("VATList entry %s has no check", e.Prefix)
+		}
+	}
+}
+
+func TestVATNumber(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected bool
+	}{
+		{"", false},
+		{"ATU13585627", true},
+		{"ATU13585628", false},
+		{"BE0428759497", true},
+		{"BE 0428.759.497", true},
+		{"BE0428759498", false},
+		{"BE428759497", false},
+		{"BG175074752", true},
+		{"BG7523169263", true},
+		{"BG175074753", false},
+		{"CHE-116.281.710 MWST", true},
+		{"CHE116281710TVA", true},
+		{"CHE116281710", false},
+		{"CHE116281711MWST", false},
+		{"CY10259033P", true},
+		{"CY10259033Q", false},
+		{"CZ25123891", true},
+		{"CZ7103192745", true},
+		{"CZ25123892", false},
+		{"DE136695976", true},
+		{"de 136 695 976", true},
+		{"DE136695977", false},
+		{"DE036695976", false},
+		{"DK13585628", true},
+		{"DK13585627", false},
+		{"EE100931558", true},
+		{"EE100931559", false},
+		{"EL094259216", true},
+		{"GR094259216", false},
+		{"EL094259217", false},
+		{"ESA28015865", true},
+		{"ESB58378431", true},
+		{"ES12345678Z", true},
+		{"ESX1234567L", true},
+		{"ESA28015866", false},
+		{"ES12345678A", false},
+		{"FI20774740", true},
+		{"FI20774741", false},
+		{"FR40303265045", true},
+		{"FR03512803495", true},
+		{"FRAB303265045", true},
+		{"FR41303265045", false},
+		{"FRIO303265045", false},
+		{"HR33392005961", true},
+		{"HR33392005962", false},
+		{"HU12892312", true},
+		{"HU12892313", false},
+		{"IE6388047V", true},
+		{"IE3628739UA", true},
+		{"IE6388047W", false},
+		{"IT00743110157", true},
+		{"IT00743110158", false},
+		{"LT119511515", true},
+		{"LT100001919017", true},
+		{"LT119511516", false},
+		{"LU15027442", true},
+		{"LU15027443", false},
+		{"LV40003521600", true},
+		{"LV40003521601", false},
+		{"MT11679112", true},
+		{"MT11679113", false},
+		{"NL004495445B01", true},
+		{"NL000099998B57", true},
+		{"NL004495446B01", false},
+		{"NL004495445", false},
+		{"NO923609016MVA", true},
+		{"NO 923 609 016 MVA", true},
+		{"NO923609016", false},
+		{"NO923609017MVA", false},
+		{"PL8567346215", true},
+		{"PL8567346216", false},
+		{"PT501964843", true},
+		{"PT501964844", false},
+		{"RO18547290", true},
+		{"RO18547291", false},
+		{"SE556188840401", true},
+		{"SE556188840402", false},
+		{"SE556188840501", false},
+		{"SI50223054", true},
+		{"SI50223055", false},
+		{"SK2022749619", true},
+		{"SK2022749610", false},
+		{"GB980780684", true},
+		{"GB 980 7806 84", true},
+		{"GB100000034", true},
+		{"GB980780684001", true},
+		{"GBGD001", true},
+		{"GBHA599", true},
+		{"GBGD599", false},
+		{"GB980780685", false},
+		{"XI980780684", true},
+		{"US123456789", false},
+		{"DE13669597", false},
+		{"DE136695976!", false},
+	}
+	for _, test := range tests {
+		if actual := VATNumber(test.param); actual != test.expected {
+			t.Errorf("Expected VATNumber(%q) to be %v, got %v", test.param, test.expected, actual)
+		}
+	}
+}
+
+func TestParseVATNumber(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param    string
+		expected VATNumberParts
+	}{
+		{"DE136695976", VATNumberParts{"DE", "DE", "136695976"}},
+		{"el 094259216", VATNumberParts{"EL", "GR", "094259216"}},
+		{"XI980780684", VATNumberParts{"XI", "GB", "980780684"}},
+		{"CHE-116.281.710 MWST", VATNumberParts{"CHE", "CH", "116281710MWST"}},
+	}
+	for _, test := range tests {
+		actual, err := ParseVATNumber(test.param)
+		if err != nil || actual != test.expected {
+			t.Errorf("Expected ParseVATNumber(%q) to be %+v, got %+v, %v", test.param, test.expected, actual, err)
+		}
+	}
+}
+
+func TestParseVATNumberErrors(t *testing.T) {
+	t.Parallel()
+
+	var tests = []struct {
+		param  string
+		code   string
+		offset int
+	}{
+		{"", CodeEmpty, -1},
+		{"D", CodeTooShort, -1},
+		{"DE136695976!", CodeInvalidChar, 11},
+		{"DE1366959761234567", CodeTooLong, 16},
+		{"GR094259216", CodeUnknownCountry, -1},
+		{"DE13669597", CodeBadFormat, -1},
+		{"DE136695977", CodeBadChecksum, -1},
+	}
+	for _, test := range tests {
+		_, err := ParseVATNumber(test.param)
+		ve, ok := err.(*ValidationError)
+		if !ok || ve.Validator != "VATNumber" || ve.Code != test.code || ve.Offset != test.offset {
+			t.Errorf("Expected ParseVATNumber(%q) to fail with %s at %d, got %v", test.param, test.code, test.offset, err)
+		}
+	}
+}